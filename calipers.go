@@ -0,0 +1,105 @@
+package convexhull
+
+import (
+	"math"
+)
+
+// rotateCalipers calls fn for every edge of the polygon, from vertex i to
+// vertex i+1, together with the vertex j that is farthest away from the
+// edge. If the edge starting at j is parallel to edge i, parallel is true.
+// The farthest vertex only moves forward while the edges are visited, so the
+// whole rotation runs in O(n).
+func (cp ConvexPolygon) rotateCalipers(fn func(i, j int, parallel bool)) {
+	v := cp.vertices
+	n := len(v)
+	if n < 3 {
+		return
+	}
+	j := 1
+	for i := 0; i < n; i++ {
+		a, b := v[i], v[(i+1)%n]
+		for Area2(a, b, v[(j+1)%n]) > Area2(a, b, v[j]) {
+			j = (j + 1) % n
+		}
+		fn(i, j, Area2(a, b, v[(j+1)%n]) == Area2(a, b, v[j]))
+	}
+}
+
+// AntipodalPairs returns all pairs of vertex indices that admit parallel
+// supporting lines. The indices refer to the order returned by Vertices.
+func (cp ConvexPolygon) AntipodalPairs() [][2]int {
+	n := len(cp.vertices)
+	switch n {
+	case 0, 1:
+		return nil
+	case 2:
+		return [][2]int{{0, 1}}
+	}
+
+	var ret [][2]int
+	seen := make(map[[2]int]bool)
+	add := func(a, b int) {
+		if a > b {
+			a, b = b, a
+		}
+		if a == b || seen[[2]int{a, b}] {
+			return
+		}
+		seen[[2]int{a, b}] = true
+		ret = append(ret, [2]int{a, b})
+	}
+	cp.rotateCalipers(func(i, j int, parallel bool) {
+		add(i, j)
+		add((i+1)%n, j)
+		if parallel {
+			add(i, (j+1)%n)
+			add((i+1)%n, (j+1)%n)
+		}
+	})
+	return ret
+}
+
+// Diameter returns the pair of vertices that are farthest apart, and the
+// distance between them
+func (cp ConvexPolygon) Diameter() (a, b Point, d float64) {
+	v := cp.vertices
+	switch len(v) {
+	case 0:
+		return a, b, 0
+	case 1:
+		return v[0], v[0], 0
+	}
+	for _, pair := range cp.AntipodalPairs() {
+		p, q := v[pair[0]], v[pair[1]]
+		if dist := math.Hypot(q.X-p.X, q.Y-p.Y); dist > d {
+			a, b, d = p, q, dist
+		}
+	}
+	return a, b, d
+}
+
+// Width returns the smallest distance between two parallel lines that
+// enclose the polygon, and the unit direction in which it is measured
+func (cp ConvexPolygon) Width() (width float64, dir Point) {
+	v := cp.vertices
+	n := len(v)
+	if n == 2 {
+		// The width of a segment is measured across it
+		dx, dy := v[1].X-v[0].X, v[1].Y-v[0].Y
+		l := math.Hypot(dx, dy)
+		return 0, Point{-dy / l, dx / l}
+	}
+	width = math.Inf(1)
+	cp.rotateCalipers(func(i, j int, _ bool) {
+		a, b := v[i], v[(i+1)%n]
+		l := math.Hypot(b.X-a.X, b.Y-a.Y)
+		if w := Area2(a, b, v[j]) / l; w < width {
+			// The inward normal of a counter-clockwise edge points left
+			width, dir = w, Point{-(b.Y - a.Y) / l, (b.X - a.X) / l}
+		}
+	})
+	if math.IsInf(width, 1) {
+		return 0, dir
+	}
+	return width, dir
+}
@@ -0,0 +1,36 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleConvexPolygon_AntipodalPairs() {
+	rect, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 3}, &Point{0, 3}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(rect.AntipodalPairs())
+	// Output:
+	// [[0 2] [1 2] [0 3] [1 3] [2 3] [0 1]]
+}
+
+func ExampleConvexPolygon_Diameter() {
+	rect, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 3}, &Point{0, 3}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(rect.Diameter())
+	// Output:
+	// {0 0} {4 3} 5
+}
+
+func ExampleConvexPolygon_Width() {
+	rect, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 3}, &Point{0, 3}})
+	if err != nil {
+		panic(err)
+	}
+	width, dir := rect.Width()
+	fmt.Println(width, dir == Point{0, 1})
+	// Output:
+	// 3 true
+}
@@ -0,0 +1,111 @@
+package convexhull
+
+import (
+	"math"
+	"math/rand"
+)
+
+// circle is a center and a radius, used while searching for enclosing circles
+type circle struct {
+	c Point
+	r float64
+}
+
+// contains returns true if p is inside the circle, allowing for a small
+// rounding error relative to the size of the circle
+func (c circle) contains(p Point) bool {
+	return math.Hypot(p.X-c.c.X, p.Y-c.c.Y) <= c.r*(1+1e-12)+1e-12
+}
+
+// circle2 returns the smallest circle through two points
+func circle2(a, b Point) circle {
+	c := Point{(a.X + b.X) / 2, (a.Y + b.Y) / 2}
+	return circle{c, math.Hypot(a.X-c.X, a.Y-c.Y)}
+}
+
+// circle3 returns the smallest circle that encloses three points, which is
+// the circumcircle unless the points are collinear
+func circle3(a, b, c Point) circle {
+	bx, by := b.X-a.X, b.Y-a.Y
+	cx, cy := c.X-a.X, c.Y-a.Y
+	d := 2 * (bx*cy - by*cx)
+	if d == 0 {
+		// Collinear, use the two points that are farthest apart
+		best := circle2(a, b)
+		for _, cand := range []circle{circle2(a, c), circle2(b, c)} {
+			if cand.r > best.r {
+				best = cand
+			}
+		}
+		return best
+	}
+	b2, c2 := bx*bx+by*by, cx*cx+cy*cy
+	x := (cy*b2 - by*c2) / d
+	y := (bx*c2 - cx*b2) / d
+	return circle{Point{a.X + x, a.Y + y}, math.Hypot(x, y)}
+}
+
+// MinEnclosingCircle returns the smallest circle that encloses all the
+// points. Only the vertices of the convex hull are considered, and they are
+// shuffled with a fixed seed, so the result is always the same for the same
+// points.
+func MinEnclosingCircle(ps Points) (center Point, radius float64) {
+	return MinEnclosingCircleHullSeed(ps, 1)
+}
+
+// MinEnclosingCircleSeed returns the smallest circle that encloses all the
+// points, using Welzl's randomized algorithm in expected O(n) time. The seed
+// is used for shuffling the points, so the result is the same for the same
+// points and seed.
+func MinEnclosingCircleSeed(ps Points, seed int64) (center Point, radius float64) {
+	// Shuffling changes the order, so work on a copy
+	qs := make(Points, len(ps))
+	copy(qs, ps)
+	return welzl(qs, seed)
+}
+
+// MinEnclosingCircleHullSeed is like MinEnclosingCircleSeed, but the points
+// are first reduced to the vertices of their convex hull, in O(n log n).
+// This is faster when only a few of the points are on the hull.
+func MinEnclosingCircleHullSeed(ps Points, seed int64) (center Point, radius float64) {
+	v := hullOf(ps).vertices
+	qs := make(Points, len(v))
+	for i := range v {
+		qs[i] = &v[i]
+	}
+	return welzl(qs, seed)
+}
+
+// welzl shuffles the points with the seed, and then finds the smallest
+// enclosing circle by adding one point at a time. A point outside the
+// current circle must be on the boundary of the new one.
+func welzl(qs Points, seed int64) (center Point, radius float64) {
+	if len(qs) == 0 {
+		return center, 0
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	r.Shuffle(len(qs), func(i, j int) {
+		qs[i], qs[j] = qs[j], qs[i]
+	})
+
+	c := circle{*qs[0], 0}
+	for i := 1; i < len(qs); i++ {
+		if c.contains(*qs[i]) {
+			continue
+		}
+		c = circle{*qs[i], 0}
+		for j := 0; j < i; j++ {
+			if c.contains(*qs[j]) {
+				continue
+			}
+			c = circle2(*qs[i], *qs[j])
+			for k := 0; k < j; k++ {
+				if !c.contains(*qs[k]) {
+					c = circle3(*qs[i], *qs[j], *qs[k])
+				}
+			}
+		}
+	}
+	return c.c, c.r
+}
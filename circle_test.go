@@ -0,0 +1,74 @@
+package convexhull
+
+import (
+	"fmt"
+	"math"
+)
+
+func ExampleMinEnclosingCircle() {
+	inside := func(ps Points, center Point, radius float64) bool {
+		for _, p := range ps {
+			if math.Hypot(p.X-center.X, p.Y-center.Y) > radius*(1+1e-9) {
+				return false
+			}
+		}
+		return true
+	}
+
+	ps := Points{
+		&Point{0, 0},
+		&Point{4, 0},
+		&Point{0, 4},
+		&Point{1, 1},
+	}
+	center, radius := MinEnclosingCircle(ps)
+	fmt.Printf("%.3f %.3f %.3f %v\n", center.X, center.Y, radius, inside(ps, center, radius))
+
+	// Points on a line, and points that are almost on a line
+	collinear := Points{
+		&Point{1, 1},
+		&Point{3, 3},
+		&Point{-1, -1},
+		&Point{2, 2},
+	}
+	center, radius = MinEnclosingCircle(collinear)
+	fmt.Printf("%.3f %.3f %.3f %v\n", center.X, center.Y, radius, inside(collinear, center, radius))
+
+	almost := Points{
+		&Point{1.33, 0.811},
+		&Point{0.696, 0.427},
+		&Point{1.205, 0.736},
+		&Point{2.187, 1.331},
+		&Point{3.051, 1.855},
+		&Point{2.560, 1.557},
+	}
+	center, radius = MinEnclosingCircle(almost)
+	fmt.Println(inside(almost, center, radius))
+	// Output:
+	// 2.000 2.000 2.828 true
+	// 1.000 1.000 2.828 true
+	// true
+}
+
+func ExampleMinEnclosingCircleSeed() {
+	ps := Points{
+		&Point{0, 0},
+		&Point{6, 1},
+		&Point{2, 5},
+		&Point{3, 2},
+		&Point{1, 3},
+		&Point{5, 4},
+		&Point{4, -1},
+	}
+	for _, seed := range []int64{1, 42} {
+		center, radius := MinEnclosingCircleSeed(ps, seed)
+		fmt.Printf("%.3f %.3f %.3f\n", center.X, center.Y, radius)
+		center, radius = MinEnclosingCircleHullSeed(ps, seed)
+		fmt.Printf("%.3f %.3f %.3f\n", center.X, center.Y, radius)
+	}
+	// Output:
+	// 2.786 1.786 3.309
+	// 2.786 1.786 3.309
+	// 2.786 1.786 3.309
+	// 2.786 1.786 3.309
+}
@@ -0,0 +1,127 @@
+package convexhull
+
+// IntersectLine returns the points where the line through p in the direction
+// dir enters and leaves the polygon. Seen across the line, the boundary
+// rises from the lowest vertex to the highest on one side and falls back on
+// the other, so after finding both with Support, each crossing is found by
+// binary search, in O(log n). If the line misses the polygon, ok is false.
+// If it only touches a vertex, entry and exit are the same point.
+func (cp ConvexPolygon) IntersectLine(p, dir Point) (entry, exit Point, ok bool) {
+	v := cp.vertices
+	n := len(v)
+	if n == 0 || dir == (Point{}) {
+		return entry, exit, false
+	}
+
+	// f is the distance of vertex i to the left of the line, scaled by the
+	// length of dir
+	f := func(i int) float64 {
+		q := v[i%n]
+		return dir.X*(q.Y-p.Y) - dir.Y*(q.X-p.X)
+	}
+	// along orders points on the line by their position in the direction
+	// of dir
+	along := func(q Point) float64 {
+		return dot(Point{q.X - p.X, q.Y - p.Y}, dir)
+	}
+
+	normal := Point{-dir.Y, dir.X}
+	hi := cp.supportIndex(normal)
+	lo := cp.supportIndex(Point{-normal.X, -normal.Y})
+	fhi, flo := f(hi), f(lo)
+	if fhi < 0 || flo > 0 {
+		return entry, exit, false
+	}
+
+	if fhi == 0 || flo == 0 {
+		// The polygon is on one side of the line, and touches it in a
+		// vertex or along an edge
+		touching := hi
+		if flo == 0 {
+			touching = lo
+		}
+		entry, exit = v[touching], v[touching]
+		for _, i := range []int{touching + n - 1, touching + 1} {
+			if f(i) == 0 {
+				q := v[i%n]
+				if along(q) < along(entry) {
+					entry = q
+				}
+				if along(q) > along(exit) {
+					exit = q
+				}
+			}
+		}
+		return entry, exit, true
+	}
+
+	// crossing returns the point where the edge ending in vertex k crosses
+	// the line
+	crossing := func(k int) Point {
+		a, b := f(k-1), f(k)
+		if b == 0 {
+			return v[k%n]
+		}
+		return lerp(v[(k-1)%n], v[k%n], a/(a-b))
+	}
+
+	// Going counter-clockwise from the lowest vertex to the highest, the
+	// boundary crosses the line from right to left where the line leaves
+	if hi < lo {
+		hi += n
+	}
+	a, b := lo, hi
+	for b-a > 1 {
+		mid := (a + b) / 2
+		if f(mid) >= 0 {
+			b = mid
+		} else {
+			a = mid
+		}
+	}
+	exit = crossing(b)
+
+	a, b = hi, lo+n
+	if a >= n {
+		a -= n
+		b -= n
+	}
+	for b-a > 1 {
+		mid := (a + b) / 2
+		if f(mid) <= 0 {
+			b = mid
+		} else {
+			a = mid
+		}
+	}
+	entry = crossing(b)
+
+	return entry, exit, true
+}
+
+// ClipSegment returns the part of the segment from a to b that is inside
+// the polygon, from the point where it enters to the point where it leaves,
+// in O(log n). If the segment misses the polygon, ok is false.
+func (cp ConvexPolygon) ClipSegment(a, b Point) (entry, exit Point, ok bool) {
+	if a == b {
+		return a, b, cp.Contains(a)
+	}
+	dir := Point{b.X - a.X, b.Y - a.Y}
+	entry, exit, ok = cp.IntersectLine(a, dir)
+	if !ok {
+		return entry, exit, false
+	}
+	l := dot(dir, dir)
+	tin := dot(Point{entry.X - a.X, entry.Y - a.Y}, dir) / l
+	tout := dot(Point{exit.X - a.X, exit.Y - a.Y}, dir) / l
+	if tin > 1 || tout < 0 {
+		return Point{}, Point{}, false
+	}
+	if tin <= 0 {
+		entry = a
+	}
+	if tout >= 1 {
+		exit = b
+	}
+	return entry, exit, true
+}
@@ -0,0 +1,41 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleConvexPolygon_IntersectLine() {
+	square, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 4}, &Point{0, 4}})
+	if err != nil {
+		panic(err)
+	}
+	// Across, missing, through a vertex, and along an edge
+	fmt.Println(square.IntersectLine(Point{-1, 1}, Point{1, 0}))
+	fmt.Println(square.IntersectLine(Point{0, 5}, Point{1, 0}))
+	fmt.Println(square.IntersectLine(Point{3, 5}, Point{1, -1}))
+	fmt.Println(square.IntersectLine(Point{4, 7}, Point{0, -1}))
+	// Output:
+	// {0 1} {4 1} true
+	// {0 0} {0 0} false
+	// {4 4} {4 4} true
+	// {4 4} {4 0} true
+}
+
+func ExampleConvexPolygon_ClipSegment() {
+	square, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 4}, &Point{0, 4}})
+	if err != nil {
+		panic(err)
+	}
+	// Through, missing, starting inside, ending inside, inside and short
+	fmt.Println(square.ClipSegment(Point{-1, 1}, Point{5, 1}))
+	fmt.Println(square.ClipSegment(Point{5, 1}, Point{7, 1}))
+	fmt.Println(square.ClipSegment(Point{2, 2}, Point{6, 2}))
+	fmt.Println(square.ClipSegment(Point{2, -2}, Point{2, 2}))
+	fmt.Println(square.ClipSegment(Point{1, 1}, Point{3, 2}))
+	// Output:
+	// {0 1} {4 1} true
+	// {0 0} {0 0} false
+	// {2 2} {4 2} true
+	// {2 0} {2 2} true
+	// {1 1} {3 2} true
+}
@@ -0,0 +1,283 @@
+package convexhull
+
+import (
+	"errors"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// Location is the position of a point relative to a polygon
+type Location int
+
+const (
+	Outside Location = iota
+	Inside
+	Boundary
+)
+
+func (l Location) String() string {
+	switch l {
+	case Inside:
+		return "inside"
+	case Boundary:
+		return "boundary"
+	}
+	return "outside"
+}
+
+// ConvexPolygon is a convex polygon with its vertices in counter-clockwise
+// order, without duplicate or collinear vertices
+type ConvexPolygon struct {
+	vertices []Point
+}
+
+// NewConvexPolygon creates a ConvexPolygon from a hull, as returned by
+// Compute. The vertices may be given in either orientation. Duplicate and
+// collinear vertices are removed.
+func NewConvexPolygon(hull Points) (ConvexPolygon, error) {
+	vs := make([]Point, 0, len(hull))
+	for _, p := range hull {
+		if len(vs) == 0 || vs[len(vs)-1] != *p {
+			vs = append(vs, *p)
+		}
+	}
+	for len(vs) > 1 && vs[0] == vs[len(vs)-1] {
+		vs = vs[:len(vs)-1]
+	}
+	if len(vs) < 3 {
+		return ConvexPolygon{}, errors.New("Too few points")
+	}
+
+	// Make the orientation counter-clockwise
+	var area float64
+	for i := 1; i < len(vs)-1; i++ {
+		area += Area2(vs[0], vs[i], vs[i+1])
+	}
+	if area == 0 {
+		return ConvexPolygon{}, errors.New("Points are collinear")
+	}
+	if area < 0 {
+		for i, j := 0, len(vs)-1; i < j; i, j = i+1, j-1 {
+			vs[i], vs[j] = vs[j], vs[i]
+		}
+	}
+
+	vs = removeCollinear(vs)
+	if len(vs) < 3 {
+		return ConvexPolygon{}, errors.New("Too few points")
+	}
+
+	// Every turn must be to the left, and the vertices must wind around
+	// the first vertex exactly once
+	n := len(vs)
+	for i := range vs {
+		if !isLeft(vs[i], vs[(i+1)%n], vs[(i+2)%n]) {
+			return ConvexPolygon{}, errors.New("Polygon is not convex")
+		}
+	}
+	for i := 1; i < n-1; i++ {
+		if !isLeft(vs[0], vs[i], vs[i+1]) {
+			return ConvexPolygon{}, errors.New("Polygon is not convex")
+		}
+	}
+
+	return ConvexPolygon{vs}, nil
+}
+
+// removeCollinear removes vertices that lie on the line through their
+// neighbours, until no such vertices are left
+func removeCollinear(vs []Point) []Point {
+	return removeVertices(vs, func(prev, p, next Point) bool {
+		return Area2(prev, p, next) == 0
+	})
+}
+
+// removeConcave removes vertices that do not turn left, until no such
+// vertices are left. Rounding errors can make almost collinear vertices of
+// a hull turn slightly the wrong way.
+func removeConcave(vs []Point) []Point {
+	return removeVertices(vs, func(prev, p, next Point) bool {
+		return Area2(prev, p, next) <= 0
+	})
+}
+
+// removeVertices removes the vertices for which drop returns true, given
+// the neighbours that are left, until no such vertices are left
+func removeVertices(vs []Point, drop func(prev, p, next Point) bool) []Point {
+	for changed := true; changed && len(vs) >= 3; {
+		changed = false
+		out := vs[:0:0]
+		n := len(vs)
+		for i := range vs {
+			prev := vs[(i+n-1)%n]
+			if len(out) > 0 {
+				prev = out[len(out)-1]
+			}
+			if drop(prev, vs[i], vs[(i+1)%n]) {
+				changed = true
+				continue
+			}
+			out = append(out, vs[i])
+		}
+		vs = out
+	}
+	return vs
+}
+
+// Vertices returns the vertices of the polygon, in counter-clockwise order
+func (cp ConvexPolygon) Vertices() Points {
+	ret := make(Points, len(cp.vertices))
+	for i := range cp.vertices {
+		p := cp.vertices[i]
+		ret[i] = &p
+	}
+	return ret
+}
+
+// Len returns the number of vertices
+func (cp ConvexPolygon) Len() int {
+	return len(cp.vertices)
+}
+
+// onSegment returns true if p lies on the closed segment from a to b
+func onSegment(a, b, p Point) bool {
+	if Area2(a, b, p) != 0 {
+		return false
+	}
+	return (p.X-a.X)*(p.X-b.X) <= 0 && (p.Y-a.Y)*(p.Y-b.Y) <= 0
+}
+
+// Classify returns if p is inside, outside or on the boundary of the polygon.
+// It runs in O(log n) by binary searching the fan of triangles from the
+// first vertex.
+func (cp ConvexPolygon) Classify(p Point) Location {
+	v := cp.vertices
+	n := len(v)
+	switch n {
+	case 0:
+		return Outside
+	case 1:
+		if p == v[0] {
+			return Boundary
+		}
+		return Outside
+	case 2:
+		if onSegment(v[0], v[1], p) {
+			return Boundary
+		}
+		return Outside
+	}
+
+	a := Area2(v[0], v[1], p)
+	b := Area2(v[0], v[n-1], p)
+	if a < 0 || b > 0 {
+		return Outside
+	}
+
+	// Find the wedge v[lo], v[lo+1] that contains p
+	lo := cp.fanWedge(p)
+
+	c := Area2(v[lo], v[lo+1], p)
+	if c < 0 {
+		return Outside
+	}
+	if c == 0 || a == 0 || b == 0 {
+		return Boundary
+	}
+	return Inside
+}
+
+// Contains returns true if p is inside or on the boundary of the polygon
+func (cp ConvexPolygon) Contains(p Point) bool {
+	return cp.Classify(p) != Outside
+}
+
+// OnBoundary returns true if p lies on one of the edges of the polygon
+func (cp ConvexPolygon) OnBoundary(p Point) bool {
+	return cp.Classify(p) == Boundary
+}
+
+// ClassifyAll classifies many points at once, spreading the work over all
+// available CPUs. The returned slice has one Location per point.
+func (cp ConvexPolygon) ClassifyAll(ps Points) []Location {
+	ret := make([]Location, len(ps))
+	parallel(len(ps), func(i int) {
+		ret[i] = cp.Classify(*ps[i])
+	})
+	return ret
+}
+
+// parallel calls fn for every index from 0 to n-1, spreading the calls over
+// all available CPUs in chunks
+func parallel(n int, fn func(i int)) {
+	workers := runtime.GOMAXPROCS(0)
+	chunk := (n + workers - 1) / workers
+	if chunk < 1024 {
+		chunk = 1024
+	}
+
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				fn(i)
+			}
+		}(start, end)
+	}
+	wg.Wait()
+}
+
+// hullOf returns the convex hull of the points as a ConvexPolygon, without
+// changing the order of the given points. If the points are collinear, the
+// result is the segment between the two extreme points, and a single point
+// gives a single vertex.
+//
+// Andrew's monotone chain is used instead of Compute. Sorting by angle is
+// not reliable for almost collinear points, while the points with the
+// smallest and largest coordinates always end up in the hull.
+func hullOf(ps Points) ConvexPolygon {
+	if len(ps) == 0 {
+		return ConvexPolygon{}
+	}
+	vs := make([]Point, len(ps))
+	for i, p := range ps {
+		vs[i] = *p
+	}
+	sort.Slice(vs, func(i, j int) bool {
+		return vs[i].X < vs[j].X || (vs[i].X == vs[j].X && vs[i].Y < vs[j].Y)
+	})
+
+	// Build the lower chain from left to right, then the upper chain from
+	// right to left. The last point of each chain starts the other one.
+	hull := make([]Point, 0, len(vs)+1)
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for i := range vs {
+			p := vs[i]
+			if pass == 1 {
+				p = vs[len(vs)-1-i]
+			}
+			for len(hull) >= start+2 && !isLeft(hull[len(hull)-2], hull[len(hull)-1], p) {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		hull = hull[:len(hull)-1]
+	}
+	if hull = removeConcave(hull); len(hull) >= 3 {
+		return ConvexPolygon{hull}
+	}
+
+	lo, hi := vs[0], vs[len(vs)-1]
+	if lo == hi {
+		return ConvexPolygon{[]Point{lo}}
+	}
+	return ConvexPolygon{[]Point{lo, hi}}
+}
@@ -0,0 +1,34 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleConvexPolygon_Classify() {
+	ps := Points{
+		&Point{0, 0},
+		&Point{4, 0},
+		&Point{4, 4},
+		&Point{0, 4},
+		&Point{2, 1},
+	}
+	hull, err := ps.Compute()
+	if err != nil {
+		panic(err)
+	}
+	cp, err := NewConvexPolygon(hull)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(cp.Vertices())
+	fmt.Println(cp.Classify(Point{1, 1}))
+	fmt.Println(cp.Classify(Point{4, 2}))
+	fmt.Println(cp.Classify(Point{5, 2}))
+	fmt.Println(cp.ClassifyAll(Points{&Point{0, 0}, &Point{3, 3}, &Point{-1, 0}}))
+	// Output:
+	// [{4 0} {4 4} {0 4} {0 0}]
+	// inside
+	// boundary
+	// outside
+	// [boundary inside outside]
+}
@@ -0,0 +1,228 @@
+package convexhull
+
+import (
+	"errors"
+	"sort"
+)
+
+// ray is the direction from a center to one of the points, with the number
+// of points strictly to the left and right of the line through it, and on
+// the line in the same and in the opposite direction
+type ray struct {
+	index                       int
+	d                           Point
+	left, right, same, opposite int
+}
+
+// rays sorts the directions from q to the points by angle, and counts the
+// points on each side of the line through each direction, in O(n log n).
+// The number of points that are equal to q is returned separately.
+func rays(ps Points, q Point) (rs []ray, equal int) {
+	for i, p := range ps {
+		d := Point{p.X - q.X, p.Y - q.Y}
+		if d == (Point{}) {
+			equal++
+			continue
+		}
+		rs = append(rs, ray{index: i, d: d})
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return lessAngle(rs[i].d, rs[j].d)
+	})
+
+	m := len(rs)
+	at := func(i int) Point {
+		return rs[i%m].d
+	}
+	cross := func(a, b Point) float64 {
+		return a.X*b.Y - a.Y*b.X
+	}
+	sameDirection := func(a, b Point) bool {
+		return cross(a, b) == 0 && dot(a, b) > 0
+	}
+
+	// Directions that are the same are next to each other
+	for start := 0; start < m; {
+		end := start + 1
+		for end < m && sameDirection(rs[start].d, rs[end].d) {
+			end++
+		}
+		for i := start; i < end; i++ {
+			rs[i].same = end - start
+		}
+		start = end
+	}
+
+	// Going around, the points to the left are from s up to e, and the
+	// points in the opposite direction are from e up to f
+	s, e, f := 0, 0, 0
+	for a := 0; a < m; a++ {
+		da := rs[a].d
+		if s < a+1 {
+			s = a + 1
+		}
+		for s < a+m && sameDirection(da, at(s)) {
+			s++
+		}
+		if e < s {
+			e = s
+		}
+		for e < a+m && cross(da, at(e)) > 0 {
+			e++
+		}
+		if f < e {
+			f = e
+		}
+		for f < a+m && cross(da, at(f)) == 0 && dot(da, at(f)) < 0 {
+			f++
+		}
+		rs[a].left = e - s
+		rs[a].opposite = f - e
+		rs[a].right = m - rs[a].left - rs[a].same - rs[a].opposite
+	}
+	return rs, equal
+}
+
+// TukeyDepth returns the halfspace depth of q relative to the points, the
+// smallest number of points in a closed half-plane that contains q. Points
+// with a high depth are central, and the points with the highest depth
+// form the Tukey median. Only the lines through q and one of the points
+// need to be checked, turned slightly either way, which takes O(n log n).
+func TukeyDepth(ps Points, q Point) int {
+	rs, equal := rays(ps, q)
+	if len(rs) == 0 {
+		return equal
+	}
+	depth := len(rs)
+	for _, r := range rs {
+		side := r.left
+		if r.right < side {
+			side = r.right
+		}
+		line := r.same
+		if r.opposite < line {
+			line = r.opposite
+		}
+		if side+line < depth {
+			depth = side + line
+		}
+	}
+	return equal + depth
+}
+
+// depthHalfPlane is a half-plane through two of the points, with the number
+// of points strictly outside of it
+type depthHalfPlane struct {
+	h       HalfPlane
+	outside int
+}
+
+// depthHalfPlanes returns the half-planes on both sides of every line
+// through two of the points, in O(n² log n)
+func depthHalfPlanes(ps Points) []depthHalfPlane {
+	var hs []depthHalfPlane
+	for i, p := range ps {
+		rs, _ := rays(ps, *p)
+		for _, r := range rs {
+			if r.index < i {
+				continue
+			}
+			// The points to the right of the line from p along r.d are
+			// where -dy*x + dx*y <= dx*p.y - dy*p.x
+			right := HalfPlane{-r.d.Y, r.d.X, r.d.X*p.Y - r.d.Y*p.X}
+			left := HalfPlane{-right.A, -right.B, -right.C}
+			hs = append(hs, depthHalfPlane{right, r.left}, depthHalfPlane{left, r.right})
+		}
+	}
+	return hs
+}
+
+// collinearContour returns the region of depth at least k for points that
+// are all on one line. Only half-planes across the line matter, so the
+// region is the segment from the k-th point from one end to the k-th point
+// from the other end, if those are not in the wrong order.
+func collinearContour(ps Points, k int) (ConvexPolygon, bool) {
+	n := len(ps)
+	if k > n {
+		return ConvexPolygon{}, false
+	}
+	sorted := make([]Point, n)
+	for i, p := range ps {
+		sorted[i] = *p
+	}
+	less := func(a, b Point) bool {
+		return a.X < b.X || (a.X == b.X && a.Y < b.Y)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+	lo, hi := sorted[k-1], sorted[n-k]
+	if less(hi, lo) {
+		return ConvexPolygon{}, false
+	}
+	if lo == hi {
+		return ConvexPolygon{[]Point{lo}}, true
+	}
+	return ConvexPolygon{[]Point{lo, hi}}, true
+}
+
+// DepthContour returns the region where the Tukey depth is at least k, as a
+// convex polygon. The region is the part of the convex hull that is not
+// strictly outside any half-plane through two of the points that has fewer
+// than k points outside of it. The hull is clipped by each of those
+// half-planes, in O(n³) in the worst case. The region may be a segment or a
+// single point. An error is returned if k is not positive, or if no point
+// has depth k.
+func DepthContour(ps Points, k int) (ConvexPolygon, error) {
+	if k < 1 {
+		return ConvexPolygon{}, errors.New("Depth must be positive")
+	}
+	region := hullOf(ps)
+	if region.Len() < 3 {
+		if region, ok := collinearContour(ps, k); ok {
+			return region, nil
+		}
+		return ConvexPolygon{}, errors.New("No point has that depth")
+	}
+	for _, dh := range depthHalfPlanes(ps) {
+		if dh.outside < k {
+			region = region.Clip(dh.h)
+		}
+	}
+	if region.Len() == 0 {
+		return ConvexPolygon{}, errors.New("No point has that depth")
+	}
+	return region, nil
+}
+
+// DepthContours returns the regions where the Tukey depth is at least k,
+// for k from 1 up to the largest depth, as nested convex polygons. Each
+// region is found by clipping the previous one, as for DepthContour.
+func DepthContours(ps Points) []ConvexPolygon {
+	var contours []ConvexPolygon
+	region := hullOf(ps)
+	if region.Len() < 3 {
+		for k := 1; ; k++ {
+			region, ok := collinearContour(ps, k)
+			if !ok {
+				return contours
+			}
+			contours = append(contours, region)
+		}
+	}
+
+	hs := depthHalfPlanes(ps)
+	sort.SliceStable(hs, func(i, j int) bool {
+		return hs[i].outside < hs[j].outside
+	})
+	for k, i := 1, 0; k <= len(ps); k++ {
+		for ; i < len(hs) && hs[i].outside < k; i++ {
+			region = region.Clip(hs[i].h)
+		}
+		if region.Len() == 0 {
+			break
+		}
+		contours = append(contours, region)
+	}
+	return contours
+}
@@ -0,0 +1,76 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleTukeyDepth() {
+	ps := Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 4}, &Point{0, 4}, &Point{2, 2}}
+	fmt.Println(TukeyDepth(ps, Point{2, 2}))
+	fmt.Println(TukeyDepth(ps, Point{1, 2}))
+	fmt.Println(TukeyDepth(ps, Point{0, 0}))
+	fmt.Println(TukeyDepth(ps, Point{5, 2}))
+	// Output:
+	// 3
+	// 1
+	// 1
+	// 0
+}
+
+func ExampleDepthContour() {
+	ps := Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 4}, &Point{0, 4}, &Point{2, 2}}
+	for k := 1; k <= 4; k++ {
+		region, err := DepthContour(ps, k)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(region.Vertices())
+	}
+	if _, err := DepthContour(ps, 0); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// [{0 0} {4 0} {4 4} {0 4}]
+	// [{2 2}]
+	// [{2 2}]
+	// No point has that depth
+	// Depth must be positive
+}
+
+func ExampleDepthContours() {
+	ps := Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 4}, &Point{0, 4}, &Point{2, 2}}
+	for _, region := range DepthContours(ps) {
+		fmt.Println(region.Vertices())
+	}
+	collinear := Points{&Point{0, 0}, &Point{1, 1}, &Point{2, 2}, &Point{3, 3}}
+	for _, region := range DepthContours(collinear) {
+		fmt.Println(region.Vertices())
+	}
+	// Output:
+	// [{0 0} {4 0} {4 4} {0 4}]
+	// [{2 2}]
+	// [{2 2}]
+	// [{0 0} {3 3}]
+	// [{1 1} {2 2}]
+}
+
+func ExampleConvexPolygon_Clip() {
+	square, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 4}, &Point{0, 4}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(square.Clip(HalfPlane{1, 0, 2}).Vertices())
+	fmt.Println(square.Clip(HalfPlane{1, 1, 4}).Vertices())
+	fmt.Println(square.Clip(HalfPlane{1, 0, 0}).Vertices())
+	fmt.Println(square.Clip(HalfPlane{1, 1, 0}).Vertices())
+	fmt.Println(square.Clip(HalfPlane{1, 0, -1}).Len())
+	fmt.Println(square.Clip(HalfPlane{1, 0, 5}).Len())
+	// Output:
+	// [{0 0} {2 0} {2 4} {0 4}]
+	// [{0 0} {4 0} {0 4}]
+	// [{0 0} {0 4}]
+	// [{0 0}]
+	// 0
+	// 4
+}
@@ -0,0 +1,122 @@
+package convexhull
+
+import (
+	"math"
+)
+
+// Line is the line through the point P, in the direction Dir
+type Line struct {
+	P, Dir Point
+}
+
+// lerp returns the point a fraction t of the way from p to q
+func lerp(p, q Point, t float64) Point {
+	return Point{p.X + t*(q.X-p.X), p.Y + t*(q.Y-p.Y)}
+}
+
+// Distance returns the smallest distance between two convex polygons, and
+// the closest pair of points, pa on a and pb on b. The calipers rotate
+// around both polygons at once, in the same way as when merging the edges
+// of the Minkowski difference a - b, and the closest point of the difference
+// to the origin gives the closest pair, in O(n+m). If the polygons overlap,
+// the distance is 0 and pa and pb are the same point, in both polygons.
+func Distance(a, b ConvexPolygon) (d float64, pa, pb Point) {
+	va, vb := a.vertices, b.vertices
+	if len(va) == 0 || len(vb) == 0 {
+		return math.Inf(1), pa, pb
+	}
+
+	pairs := minkowskiPairs(va, mirror(vb))
+	if len(pairs) == 0 {
+		// Both polygons are single points
+		return math.Hypot(va[0].X-vb[0].X, va[0].Y-vb[0].Y), va[0], vb[0]
+	}
+	diff := func(k int) Point {
+		p, q := va[pairs[k][0]], vb[pairs[k][1]]
+		return Point{p.X - q.X, p.Y - q.Y}
+	}
+
+	inside := len(pairs) >= 3
+	d = math.Inf(1)
+	for k := range pairs {
+		k1 := (k + 1) % len(pairs)
+		p, q := diff(k), diff(k1)
+		if Area2(p, q, Point{}) < 0 {
+			inside = false
+		}
+		t := closestOnSegment(p, q)
+		c := lerp(p, q, t)
+		if dist := math.Hypot(c.X, c.Y); dist < d {
+			d = dist
+			pa = lerp(va[pairs[k][0]], va[pairs[k1][0]], t)
+			pb = lerp(vb[pairs[k][1]], vb[pairs[k1][1]], t)
+		}
+	}
+
+	if inside || d == 0 {
+		if overlap := Intersect(a, b); len(overlap.vertices) > 0 {
+			return 0, overlap.vertices[0], overlap.vertices[0]
+		}
+		return 0, pa, pa
+	}
+	return d, pa, pb
+}
+
+// SeparatingLine returns the line that separates two disjoint convex
+// polygons with the largest margin, halfway between the closest pair of
+// points. The polygon a is to the left of the line, and b is to the right.
+// If the polygons overlap or touch, there is no such line and false is
+// returned.
+func SeparatingLine(a, b ConvexPolygon) (Line, bool) {
+	d, pa, pb := Distance(a, b)
+	if d == 0 || math.IsInf(d, 1) {
+		return Line{}, false
+	}
+	return Line{
+		P:   Point{(pa.X + pb.X) / 2, (pa.Y + pb.Y) / 2},
+		Dir: Point{-(pb.Y - pa.Y) / d, (pb.X - pa.X) / d},
+	}, true
+}
+
+// ClosestPoint returns the point on the boundary of the polygon that is
+// closest to p, by checking every edge. For points outside the polygon, this
+// is also the closest point of the whole polygon.
+func (cp ConvexPolygon) ClosestPoint(p Point) Point {
+	v := cp.vertices
+	n := len(v)
+	if n == 0 {
+		return Point{}
+	}
+	best, bestDistance := v[0], math.Inf(1)
+	for i := range v {
+		a, b := v[i], v[(i+1)%n]
+		t := closestOnSegment(Point{a.X - p.X, a.Y - p.Y}, Point{b.X - p.X, b.Y - p.Y})
+		c := lerp(a, b, t)
+		if t == 1 {
+			c = b
+		}
+		if d := math.Hypot(c.X-p.X, c.Y-p.Y); d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+	return best
+}
+
+// SignedDistance returns the distance from p to the boundary of the polygon,
+// negative if p is inside and positive if it is outside. If the polygon has
+// no vertices, the distance is positive infinity.
+func (cp ConvexPolygon) SignedDistance(p Point) float64 {
+	if len(cp.vertices) == 0 {
+		return math.Inf(1)
+	}
+	location := cp.Classify(p)
+	if location == Boundary {
+		return 0
+	}
+	c := cp.ClosestPoint(p)
+	d := math.Hypot(c.X-p.X, c.Y-p.Y)
+	if location == Inside {
+		return -d
+	}
+	return d
+}
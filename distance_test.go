@@ -0,0 +1,63 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleConvexPolygon_SignedDistance() {
+	square, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 4}, &Point{0, 4}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(square.SignedDistance(Point{1, 2}))
+	fmt.Println(square.SignedDistance(Point{7, 8}))
+	fmt.Println(square.ClosestPoint(Point{2, -3}))
+	// Output:
+	// -1
+	// 5
+	// {2 0}
+}
+
+func ExampleDistance() {
+	square, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{2, 0}, &Point{2, 2}, &Point{0, 2}})
+	if err != nil {
+		panic(err)
+	}
+	triangle, err := NewConvexPolygon(Points{&Point{5, 1}, &Point{7, 0}, &Point{7, 3}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(Distance(square, triangle))
+
+	line, ok := SeparatingLine(square, triangle)
+	fmt.Println(line.P, ok)
+	q := Point{line.P.X + line.Dir.X, line.P.Y + line.Dir.Y}
+	side := func(cp ConvexPolygon) string {
+		left, right := true, true
+		for _, p := range cp.Vertices() {
+			left = left && Area2(line.P, q, *p) > 0
+			right = right && Area2(line.P, q, *p) < 0
+		}
+		switch {
+		case left:
+			return "left"
+		case right:
+			return "right"
+		}
+		return "both"
+	}
+	fmt.Println(side(square), side(triangle))
+
+	overlapping, err := NewConvexPolygon(Points{&Point{1, 1}, &Point{3, 1}, &Point{3, 3}})
+	if err != nil {
+		panic(err)
+	}
+	d, _, _ := Distance(square, overlapping)
+	_, ok = SeparatingLine(square, overlapping)
+	fmt.Println(d, ok)
+	// Output:
+	// 3 {2 1} {5 1}
+	// {3.5 1} true
+	// left right
+	// 0 false
+}
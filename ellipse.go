@@ -0,0 +1,184 @@
+package convexhull
+
+import (
+	"errors"
+	"math"
+)
+
+// Ellipse is an ellipse with semi-axes A and B, where A >= B. Angle is the
+// direction of the A axis, in radians.
+type Ellipse struct {
+	Center Point
+	A, B   float64
+	Angle  float64
+}
+
+// Area returns the area of the ellipse
+func (e Ellipse) Area() float64 {
+	return math.Pi * e.A * e.B
+}
+
+// Contains returns true if p is inside or on the ellipse
+func (e Ellipse) Contains(p Point) bool {
+	return e.norm(p) <= 1+1e-9
+}
+
+// norm returns 1 for points on the ellipse, less for points inside it and
+// more for points outside it
+func (e Ellipse) norm(p Point) float64 {
+	s, c := math.Sincos(e.Angle)
+	dx, dy := p.X-e.Center.X, p.Y-e.Center.Y
+	u := (c*dx + s*dy) / e.A
+	v := (-s*dx + c*dy) / e.B
+	return u*u + v*v
+}
+
+// inverse3 returns the inverse of a 3x3 matrix, and false if it is singular
+func inverse3(m [3][3]float64) ([3][3]float64, bool) {
+	var inv [3][3]float64
+	inv[0][0] = m[1][1]*m[2][2] - m[1][2]*m[2][1]
+	inv[0][1] = m[0][2]*m[2][1] - m[0][1]*m[2][2]
+	inv[0][2] = m[0][1]*m[1][2] - m[0][2]*m[1][1]
+	inv[1][0] = m[1][2]*m[2][0] - m[1][0]*m[2][2]
+	inv[1][1] = m[0][0]*m[2][2] - m[0][2]*m[2][0]
+	inv[1][2] = m[0][2]*m[1][0] - m[0][0]*m[1][2]
+	inv[2][0] = m[1][0]*m[2][1] - m[1][1]*m[2][0]
+	inv[2][1] = m[0][1]*m[2][0] - m[0][0]*m[2][1]
+	inv[2][2] = m[0][0]*m[1][1] - m[0][1]*m[1][0]
+	det := m[0][0]*inv[0][0] + m[0][1]*inv[1][0] + m[0][2]*inv[2][0]
+	if det == 0 {
+		return inv, false
+	}
+	for i := range inv {
+		for j := range inv[i] {
+			inv[i][j] /= det
+		}
+	}
+	return inv, true
+}
+
+// MinEnclosingEllipse returns an approximation of the smallest area ellipse
+// that encloses all the points, using Khachiyan's algorithm on the vertices
+// of the convex hull. The iteration stops when the weights of the vertices
+// change by less than tolerance. The ellipse is then scaled up slightly if
+// needed, so that it always encloses all the points.
+func MinEnclosingEllipse(ps Points, tolerance float64) (Ellipse, error) {
+	if tolerance <= 0 {
+		return Ellipse{}, errors.New("Tolerance must be positive")
+	}
+	if len(ps) < 3 {
+		return Ellipse{}, errors.New("Too few points")
+	}
+
+	cp := hullOf(ps)
+	if cp.Len() < 3 {
+		return Ellipse{}, errors.New("Points are collinear")
+	}
+	v := cp.vertices
+
+	// Move the points close to the origin, for numerical stability
+	o := cp.Centroid()
+	q := make([][3]float64, len(v))
+	for i, p := range v {
+		q[i] = [3]float64{p.X - o.X, p.Y - o.Y, 1}
+	}
+
+	const d = 2
+	n := len(q)
+	u := make([]float64, n)
+	for i := range u {
+		u[i] = 1 / float64(n)
+	}
+
+	for iteration := 0; iteration < 100000; iteration++ {
+		var x [3][3]float64
+		for i := range q {
+			for r := 0; r < 3; r++ {
+				for c := 0; c < 3; c++ {
+					x[r][c] += u[i] * q[i][r] * q[i][c]
+				}
+			}
+		}
+		xi, ok := inverse3(x)
+		if !ok {
+			return Ellipse{}, errors.New("Points are collinear")
+		}
+
+		// Find the point that is the farthest outside the current ellipse
+		j, maxM := 0, math.Inf(-1)
+		for i := range q {
+			var m float64
+			for r := 0; r < 3; r++ {
+				for c := 0; c < 3; c++ {
+					m += q[i][r] * xi[r][c] * q[i][c]
+				}
+			}
+			if m > maxM {
+				j, maxM = i, m
+			}
+		}
+
+		step := (maxM - d - 1) / ((d + 1) * (maxM - 1))
+		var change float64
+		for i := range u {
+			nu := (1 - step) * u[i]
+			if i == j {
+				nu += step
+			}
+			change += (nu - u[i]) * (nu - u[i])
+			u[i] = nu
+		}
+		if math.Sqrt(change) < tolerance {
+			break
+		}
+	}
+
+	// The center is the weighted average of the points
+	var cx, cy float64
+	for i := range q {
+		cx += u[i] * q[i][0]
+		cy += u[i] * q[i][1]
+	}
+
+	// The shape matrix is the inverse of the weighted covariance, divided by d
+	var sxx, sxy, syy float64
+	for i := range q {
+		dx, dy := q[i][0]-cx, q[i][1]-cy
+		sxx += u[i] * dx * dx
+		sxy += u[i] * dx * dy
+		syy += u[i] * dy * dy
+	}
+	det := sxx*syy - sxy*sxy
+	if det <= 0 {
+		return Ellipse{}, errors.New("Points are collinear")
+	}
+	a, b, c := syy/(d*det), -sxy/(d*det), sxx/(d*det)
+
+	// The semi-axes are given by the eigenvalues of the shape matrix, with
+	// the longest axis along the eigenvector of the smallest eigenvalue
+	mean := (a + c) / 2
+	diff := math.Hypot((a-c)/2, b)
+	small, large := mean-diff, mean+diff
+	angle := 0.5*math.Atan2(2*b, a-c) + math.Pi/2
+	if angle > math.Pi/2 {
+		angle -= math.Pi
+	}
+
+	e := Ellipse{
+		Center: Point{o.X + cx, o.Y + cy},
+		A:      1 / math.Sqrt(small),
+		B:      1 / math.Sqrt(large),
+		Angle:  angle,
+	}
+
+	// Scale the ellipse so that every point is inside it. This is checked
+	// for all the points, and not only the vertices, since rounding errors
+	// may leave a point just outside the hull, or outside a thin ellipse.
+	scale := 1.0
+	for _, p := range ps {
+		scale = math.Max(scale, e.norm(*p))
+	}
+	e.A *= math.Sqrt(scale)
+	e.B *= math.Sqrt(scale)
+	return e, nil
+}
@@ -0,0 +1,22 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleMinEnclosingEllipse() {
+	ps := Points{
+		&Point{-2, -1},
+		&Point{2, -1},
+		&Point{2, 1},
+		&Point{-2, 1},
+		&Point{0, 0},
+	}
+	e, err := MinEnclosingEllipse(ps, 1e-9)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%.3f %.3f %.3f %.3f %.3f\n", e.Center.X, e.Center.Y, e.A, e.B, e.Angle)
+	// Output:
+	// 0.000 0.000 2.828 1.414 0.000
+}
@@ -0,0 +1,181 @@
+package convexhull
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// EnvelopeLine is the line y = M*x + B
+type EnvelopeLine struct {
+	M, B float64
+}
+
+// At returns the y value of the line at x
+func (l EnvelopeLine) At(x float64) float64 {
+	return l.M*x + l.B
+}
+
+// liChaoNode is a node of a Li Chao tree. It holds the line that is best in
+// the middle of the node's interval. The other lines can only be best on
+// one side, and are passed down to that child.
+type liChaoNode struct {
+	line        EnvelopeLine
+	left, right *liChaoNode
+}
+
+// liChaoDepth limits the depth of a Li Chao tree, where halving the
+// interval again would not separate any more floating point numbers
+const liChaoDepth = 64
+
+// LineEnvelope is the lower or upper envelope of a set of lines, the
+// minimum or maximum of all of them at each x. It is the dual of the lower
+// or upper hull of a set of points, and is also known as the convex hull
+// trick. There are two modes:
+//
+// The sorted mode keeps the lines of the envelope sorted by slope, like the
+// points of a hull sorted by angle. Lines can only be added in order of
+// slope, and queries are O(log n) binary searches.
+//
+// The dynamic mode is a Li Chao tree over an interval of x values. Lines can
+// be added in any order, and both adding and queries take time in
+// proportion to the depth of the tree.
+type LineEnvelope struct {
+	upper bool
+
+	// The lines are negated for a lower envelope, so that the envelope is
+	// always an upper envelope inside
+	hull []EnvelopeLine
+
+	dynamic bool
+	lo, hi  float64
+	root    *liChaoNode
+}
+
+// NewLineEnvelope creates the envelope of the lines in sorted mode. If
+// upper is true, the envelope is the maximum of the lines, otherwise the
+// minimum. The lines are sorted by slope first, in O(n log n).
+func NewLineEnvelope(lines []EnvelopeLine, upper bool) *LineEnvelope {
+	le := &LineEnvelope{upper: upper}
+	sorted := make([]EnvelopeLine, len(lines))
+	for i, l := range lines {
+		sorted[i] = le.internal(l)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].M < sorted[j].M
+	})
+	for _, l := range sorted {
+		le.push(l)
+	}
+	return le
+}
+
+// NewLiChaoEnvelope creates an empty envelope in dynamic mode, for queries
+// with x from lo to hi. If upper is true, the envelope is the maximum of the
+// lines, otherwise the minimum.
+func NewLiChaoEnvelope(lo, hi float64, upper bool) *LineEnvelope {
+	return &LineEnvelope{upper: upper, dynamic: true, lo: lo, hi: hi}
+}
+
+// internal negates the line for a lower envelope
+func (le *LineEnvelope) internal(l EnvelopeLine) EnvelopeLine {
+	if le.upper {
+		return l
+	}
+	return EnvelopeLine{-l.M, -l.B}
+}
+
+// Add adds a line to the envelope. In sorted mode, each new line must be
+// the best one far to the right: for an upper envelope the slopes must not
+// decrease, and for a lower envelope they must not increase. An error is
+// returned otherwise.
+func (le *LineEnvelope) Add(l EnvelopeLine) error {
+	l = le.internal(l)
+	if le.dynamic {
+		le.root = le.insert(le.root, le.lo, le.hi, l, 0)
+		return nil
+	}
+	if len(le.hull) > 0 && l.M < le.hull[len(le.hull)-1].M {
+		return errors.New("Slopes must be added in sorted order")
+	}
+	le.push(l)
+	return nil
+}
+
+// push adds a line with the largest slope so far to the sorted envelope,
+// removing the lines that are no longer on top anywhere, in amortized O(1)
+func (le *LineEnvelope) push(l EnvelopeLine) {
+	if n := len(le.hull); n > 0 && le.hull[n-1].M == l.M {
+		if le.hull[n-1].B >= l.B {
+			return
+		}
+		le.hull = le.hull[:n-1]
+	}
+	for n := len(le.hull); n >= 2; n-- {
+		a, b := le.hull[n-2], le.hull[n-1]
+		// b is not needed if l overtakes a before b does
+		if (a.B-l.B)*(b.M-a.M) > (a.B-b.B)*(l.M-a.M) {
+			break
+		}
+		le.hull = le.hull[:n-1]
+	}
+	le.hull = append(le.hull, l)
+}
+
+// insert adds a line to the Li Chao tree for the interval from lo to hi
+func (le *LineEnvelope) insert(node *liChaoNode, lo, hi float64, l EnvelopeLine, depth int) *liChaoNode {
+	if node == nil {
+		return &liChaoNode{line: l}
+	}
+	mid := (lo + hi) / 2
+	leftBetter := l.At(lo) > node.line.At(lo)
+	midBetter := l.At(mid) > node.line.At(mid)
+	if midBetter {
+		node.line, l = l, node.line
+	}
+	if depth >= liChaoDepth || mid == lo || mid == hi {
+		return node
+	}
+	if leftBetter != midBetter {
+		node.left = le.insert(node.left, lo, mid, l, depth+1)
+	} else {
+		node.right = le.insert(node.right, mid, hi, l, depth+1)
+	}
+	return node
+}
+
+// Query returns the value of the envelope at x, the minimum or maximum of
+// all the lines. If there are no lines, false is returned. In dynamic mode,
+// x must be in the interval that was given when creating the envelope.
+func (le *LineEnvelope) Query(x float64) (float64, bool) {
+	var y float64
+	if le.dynamic {
+		if le.root == nil {
+			return 0, false
+		}
+		y = math.Inf(-1)
+		lo, hi := le.lo, le.hi
+		for node := le.root; node != nil; {
+			y = math.Max(y, node.line.At(x))
+			mid := (lo + hi) / 2
+			if x < mid {
+				node, hi = node.left, mid
+			} else {
+				node, lo = node.right, mid
+			}
+		}
+	} else {
+		if len(le.hull) == 0 {
+			return 0, false
+		}
+		// The lines further on are better up to the line that is on top
+		i := sort.Search(len(le.hull)-1, func(i int) bool {
+			return le.hull[i].At(x) >= le.hull[i+1].At(x)
+		})
+		y = le.hull[i].At(x)
+	}
+	if !le.upper {
+		y = -y
+	}
+	return y, true
+}
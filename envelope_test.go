@@ -0,0 +1,77 @@
+package convexhull
+
+import (
+	"fmt"
+	"math"
+)
+
+func ExampleNewLineEnvelope() {
+	lines := []EnvelopeLine{{1, 0}, {-1, 0}, {0, 1}, {0.5, -4}}
+	lower := NewLineEnvelope(lines, false)
+	upper := NewLineEnvelope(lines, true)
+	for _, x := range []float64{-3, 0, 0.5, 3} {
+		lo, _ := lower.Query(x)
+		hi, _ := upper.Query(x)
+		fmt.Println(x, lo, hi)
+	}
+	_, ok := NewLineEnvelope(nil, true).Query(0)
+	fmt.Println(ok)
+	// Output:
+	// -3 -5.5 3
+	// 0 -4 1
+	// 0.5 -3.75 1
+	// 3 -3 3
+	// false
+}
+
+func ExampleNewLiChaoEnvelope() {
+	lines := []EnvelopeLine{{0, 1}, {1, 0}, {0.5, -4}, {-1, 0}, {-2, -10}, {3, 2}}
+	lower := NewLiChaoEnvelope(-10, 10, false)
+	upper := NewLiChaoEnvelope(-10, 10, true)
+	_, ok := lower.Query(0)
+	fmt.Println(ok)
+	for _, l := range lines {
+		if err := lower.Add(l); err != nil {
+			panic(err)
+		}
+		if err := upper.Add(l); err != nil {
+			panic(err)
+		}
+	}
+
+	// Compare with the minimum and maximum of all the lines
+	same := true
+	for x := -10.0; x <= 10; x += 0.25 {
+		lo, hi := math.Inf(1), math.Inf(-1)
+		for _, l := range lines {
+			lo = math.Min(lo, l.At(x))
+			hi = math.Max(hi, l.At(x))
+		}
+		y1, _ := lower.Query(x)
+		y2, _ := upper.Query(x)
+		same = same && y1 == lo && y2 == hi
+	}
+	fmt.Println(same)
+	// Output:
+	// false
+	// true
+}
+
+func ExampleLineEnvelope_Add() {
+	upper := NewLineEnvelope(nil, true)
+	fmt.Println(upper.Add(EnvelopeLine{-1, 0}))
+	fmt.Println(upper.Add(EnvelopeLine{1, 0}))
+	fmt.Println(upper.Add(EnvelopeLine{0, 1}))
+	fmt.Println(upper.Query(0))
+
+	lower := NewLineEnvelope(nil, false)
+	fmt.Println(lower.Add(EnvelopeLine{1, 0}))
+	fmt.Println(lower.Add(EnvelopeLine{2, 0}))
+	// Output:
+	// <nil>
+	// <nil>
+	// Slopes must be added in sorted order
+	// 0 true
+	// <nil>
+	// Slopes must be added in sorted order
+}
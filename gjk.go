@@ -0,0 +1,243 @@
+package convexhull
+
+import (
+	"math"
+)
+
+func dot(a, b Point) float64 {
+	return a.X*b.X + a.Y*b.Y
+}
+
+// unit returns the vector scaled to length 1
+func unit(p Point) Point {
+	l := math.Hypot(p.X, p.Y)
+	if l == 0 {
+		return p
+	}
+	return Point{p.X / l, p.Y / l}
+}
+
+// simplexPoint is a point of the Minkowski difference a - b, together with
+// the points of a and b that it was made from
+type simplexPoint struct {
+	p, a, b Point
+}
+
+// supportDifference returns the point of a - b that is farthest in the
+// direction dir
+func supportDifference(a, b ConvexPolygon, dir Point) simplexPoint {
+	pa := a.Support(dir)
+	pb := b.Support(Point{-dir.X, -dir.Y})
+	return simplexPoint{Point{pa.X - pb.X, pa.Y - pb.Y}, pa, pb}
+}
+
+// closestOnSegment returns the point on the segment from s to t that is
+// closest to the origin, as the weight of t
+func closestOnSegment(s, t Point) float64 {
+	d := Point{t.X - s.X, t.Y - s.Y}
+	l := dot(d, d)
+	if l == 0 {
+		return 0
+	}
+	return math.Max(0, math.Min(1, -dot(s, d)/l))
+}
+
+// reduceSimplex finds the point of the simplex that is closest to the
+// origin, and reduces the simplex to the smallest set of points that still
+// holds it. The weights of the remaining points are returned. If the
+// origin is inside a triangle, the whole triangle is kept. A triangle
+// without area is treated as its edges.
+func reduceSimplex(simplex []simplexPoint) ([]simplexPoint, []float64) {
+	switch len(simplex) {
+	case 1:
+		return simplex, []float64{1}
+	case 2:
+		t := closestOnSegment(simplex[0].p, simplex[1].p)
+		switch t {
+		case 0:
+			return simplex[:1], []float64{1}
+		case 1:
+			return simplex[1:], []float64{1}
+		}
+		return simplex, []float64{1 - t, t}
+	}
+
+	p0, p1, p2 := simplex[0].p, simplex[1].p, simplex[2].p
+	a0, a1, a2 := Area2(p1, p2, Point{}), Area2(p2, p0, Point{}), Area2(p0, p1, Point{})
+	s0, s1, s2 := sign(a0), sign(a1), sign(a2)
+	if area := Area2(p0, p1, p2); area != 0 && ((s0 >= 0 && s1 >= 0 && s2 >= 0) || (s0 <= 0 && s1 <= 0 && s2 <= 0)) {
+		// The weights are the barycentric coordinates of the origin, so
+		// that the points of a and b that they give are the same
+		return simplex, []float64{a0 / area, a1 / area, a2 / area}
+	}
+
+	// The origin is outside, so the closest point is on one of the edges
+	var best []simplexPoint
+	var bestWeights []float64
+	bestDistance := math.Inf(1)
+	for i := 0; i < 3; i++ {
+		edge := []simplexPoint{simplex[i], simplex[(i+1)%3]}
+		reduced, weights := reduceSimplex(edge)
+		if d := math.Sqrt(dot(combine(reduced, weights), combine(reduced, weights))); d < bestDistance {
+			best = append([]simplexPoint(nil), reduced...)
+			bestWeights, bestDistance = weights, d
+		}
+	}
+	return best, bestWeights
+}
+
+// combine returns the weighted sum of the points of the simplex
+func combine(simplex []simplexPoint, weights []float64) Point {
+	var p Point
+	for i, s := range simplex {
+		p.X += weights[i] * s.p.X
+		p.Y += weights[i] * s.p.Y
+	}
+	return p
+}
+
+// gjk runs the Gilbert-Johnson-Keerthi algorithm on the Minkowski difference
+// of a and b. It returns the final simplex and weights, and true if the
+// polygons overlap.
+func gjk(a, b ConvexPolygon) ([]simplexPoint, []float64, bool) {
+	simplex := []simplexPoint{supportDifference(a, b, Point{1, 0})}
+	weights := []float64{1}
+	v := simplex[0].p
+
+	for i := 0; i < 2*(len(a.vertices)+len(b.vertices))+16; i++ {
+		vv := dot(v, v)
+		if vv == 0 {
+			return simplex, weights, true
+		}
+		w := supportDifference(a, b, Point{-v.X, -v.Y})
+		if vv-dot(v, w.p) <= 1e-12*vv {
+			// No point is closer to the origin than v
+			break
+		}
+		duplicate := false
+		for _, s := range simplex {
+			if s.p == w.p {
+				duplicate = true
+			}
+		}
+		if duplicate {
+			break
+		}
+		simplex, weights = reduceSimplex(append(simplex, w))
+		if len(simplex) == 3 {
+			return simplex, weights, true
+		}
+		v = combine(simplex, weights)
+	}
+
+	// Touching polygons may leave v a rounding error away from the origin
+	var scale float64
+	for _, s := range simplex {
+		scale = math.Max(scale, math.Max(math.Abs(s.p.X), math.Abs(s.p.Y)))
+	}
+	return simplex, weights, math.Sqrt(dot(v, v)) <= 1e-12*scale
+}
+
+// Overlaps returns true if the two convex polygons overlap or touch, using
+// the Gilbert-Johnson-Keerthi algorithm
+func Overlaps(a, b ConvexPolygon) bool {
+	if len(a.vertices) == 0 || len(b.vertices) == 0 {
+		return false
+	}
+	_, _, overlap := gjk(a, b)
+	return overlap
+}
+
+// GJKDistance returns the distance between two convex polygons, and the
+// closest points on each of them, using the Gilbert-Johnson-Keerthi
+// algorithm. If the polygons overlap or touch, the distance is 0 and pa and
+// pb are the same point, in both polygons.
+func GJKDistance(a, b ConvexPolygon) (d float64, pa, pb Point) {
+	if len(a.vertices) == 0 || len(b.vertices) == 0 {
+		return math.Inf(1), pa, pb
+	}
+	simplex, weights, overlap := gjk(a, b)
+	for i, s := range simplex {
+		pa.X += weights[i] * s.a.X
+		pa.Y += weights[i] * s.a.Y
+		pb.X += weights[i] * s.b.X
+		pb.Y += weights[i] * s.b.Y
+	}
+	if overlap {
+		return 0, pa, pb
+	}
+	return math.Hypot(pa.X-pb.X, pa.Y-pb.Y), pa, pb
+}
+
+// Penetration returns how deep two overlapping convex polygons penetrate
+// each other, using the Expanding Polytope Algorithm on the simplex from
+// GJK. Moving b by depth along the unit normal separates the polygons. If
+// the polygons do not overlap, ok is false.
+func Penetration(a, b ConvexPolygon) (depth float64, normal Point, ok bool) {
+	if len(a.vertices) == 0 || len(b.vertices) == 0 {
+		return 0, normal, false
+	}
+	simplex, _, overlap := gjk(a, b)
+	if !overlap {
+		return 0, normal, false
+	}
+
+	// The origin may be on the boundary of a - b, then the simplex must be
+	// grown to a triangle first
+	poly := make([]Point, 0, len(simplex))
+	for _, s := range simplex {
+		poly = append(poly, s.p)
+	}
+	for len(poly) < 3 {
+		dirs := []Point{{1, 0}, {-1, 0}, {0, 1}, {0, -1}}
+		if len(poly) == 2 {
+			d := Point{poly[1].X - poly[0].X, poly[1].Y - poly[0].Y}
+			dirs = []Point{{-d.Y, d.X}, {d.Y, -d.X}}
+		}
+		grown := false
+		for _, dir := range dirs {
+			w := supportDifference(a, b, dir).p
+			if (len(poly) == 1 && w != poly[0]) || (len(poly) == 2 && Area2(poly[0], poly[1], w) != 0) {
+				poly = append(poly, w)
+				grown = true
+				break
+			}
+		}
+		if !grown {
+			// The difference is a segment or a point, so the polygons
+			// only touch
+			return 0, unit(dirs[0]), true
+		}
+	}
+	if Area2(poly[0], poly[1], poly[2]) < 0 {
+		poly[1], poly[2] = poly[2], poly[1]
+	}
+
+	for iteration := 0; iteration < 2*(len(a.vertices)+len(b.vertices))+16; iteration++ {
+		// Find the edge that is closest to the origin
+		closest, n := 0, Point{}
+		closestDistance := math.Inf(1)
+		for i := range poly {
+			p, q := poly[i], poly[(i+1)%len(poly)]
+			l := math.Hypot(q.X-p.X, q.Y-p.Y)
+			if l == 0 {
+				continue
+			}
+			en := Point{(q.Y - p.Y) / l, -(q.X - p.X) / l}
+			if d := dot(en, p); d < closestDistance {
+				closest, n, closestDistance = i, en, d
+			}
+		}
+
+		w := supportDifference(a, b, n).p
+		if dot(w, n)-closestDistance <= 1e-12*(1+math.Abs(closestDistance)) {
+			depth, normal = closestDistance, n
+			break
+		}
+		poly = append(poly, Point{})
+		copy(poly[closest+2:], poly[closest+1:])
+		poly[closest+1] = w
+		depth, normal = closestDistance, n
+	}
+	return math.Max(0, depth), normal, true
+}
@@ -0,0 +1,49 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExamplePenetration() {
+	a, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{2, 0}, &Point{2, 1}, &Point{0, 1}})
+	if err != nil {
+		panic(err)
+	}
+	b, err := NewConvexPolygon(Points{&Point{1.5, 0}, &Point{3.5, 0}, &Point{3.5, 1}, &Point{1.5, 1}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(Overlaps(a, b))
+	depth, normal, ok := Penetration(a, b)
+	fmt.Println(depth, normal.X, ok)
+	// Output:
+	// true
+	// 0.5 1 true
+}
+
+func ExampleGJKDistance() {
+	square, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{2, 0}, &Point{2, 2}, &Point{0, 2}})
+	if err != nil {
+		panic(err)
+	}
+	edge, err := NewConvexPolygon(Points{&Point{5, 1}, &Point{7, 0}, &Point{7, 3}})
+	if err != nil {
+		panic(err)
+	}
+	corner, err := NewConvexPolygon(Points{&Point{5, 6}, &Point{7, 6}, &Point{6, 8}})
+	if err != nil {
+		panic(err)
+	}
+	touching, err := NewConvexPolygon(Points{&Point{2, 1}, &Point{4, 0}, &Point{4, 2}})
+	if err != nil {
+		panic(err)
+	}
+	for _, b := range []ConvexPolygon{edge, corner, touching} {
+		d, pa, pb := GJKDistance(square, b)
+		fmt.Printf("%.3f (%.3f, %.3f) (%.3f, %.3f)\n", d, pa.X, pa.Y, pb.X, pb.Y)
+	}
+	// Output:
+	// 3.000 (2.000, 1.000) (5.000, 1.000)
+	// 5.000 (2.000, 2.000) (5.000, 6.000)
+	// 0.000 (2.000, 1.000) (2.000, 1.000)
+}
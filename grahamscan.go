@@ -36,11 +36,17 @@ func (ps Points) Swap(i, j int) {
 }
 
 func (ps Points) Less(i, j int) bool {
-	area := Area2(*(ps[0]), *(ps[i]), *(ps[j]))
+	return lessAround(*(ps[0]), *(ps[i]), *(ps[j]))
+}
+
+// lessAround orders points by angle around the pivot p0, and by distance
+// from p0 if the angles are equal
+func lessAround(p0, a, b Point) bool {
+	area := Area2(p0, a, b)
 
 	if area == 0 {
-		x := math.Abs(ps[i].X-ps[0].X) - math.Abs(ps[j].X-ps[0].X)
-		y := math.Abs(ps[i].Y-ps[0].Y) - math.Abs(ps[j].Y-ps[0].Y)
+		x := math.Abs(a.X-p0.X) - math.Abs(b.X-p0.X)
+		y := math.Abs(a.Y-p0.Y) - math.Abs(b.Y-p0.Y)
 
 		if x < 0 || y < 0 {
 			return true
@@ -58,6 +64,25 @@ func (ps Points) Less(i, j int) bool {
 	return area > 0
 }
 
+// polarOrder sorts points around a fixed pivot. Unlike sorting Points
+// directly, the pivot can not be moved away by the sort.
+type polarOrder struct {
+	p0 Point
+	ps Points
+}
+
+func (po polarOrder) Len() int {
+	return len(po.ps)
+}
+
+func (po polarOrder) Swap(i, j int) {
+	po.ps[i], po.ps[j] = po.ps[j], po.ps[i]
+}
+
+func (po polarOrder) Less(i, j int) bool {
+	return lessAround(po.p0, *(po.ps[i]), *(po.ps[j]))
+}
+
 func (ps Points) Lowest() {
 	m := 0
 	for i := 1; i < len(ps); i++ {
@@ -77,7 +102,7 @@ func (ps Points) Compute() (Points, error) {
 	stack := new(PointStack)
 
 	ps.Lowest()
-	sort.Sort(&ps)
+	sort.Sort(polarOrder{*(ps[0]), ps[1:]})
 
 	stack.Push(*(ps[0]))
 	stack.Push(*(ps[1]))
@@ -90,6 +115,13 @@ func (ps Points) Compute() (Points, error) {
 
 		//PrintStack(stack)
 
+		// Collinear and duplicate points may pop all but the lowest point
+		if stack.Len() < 2 {
+			stack.Push(pi)
+			i++
+			continue
+		}
+
 		p1 := stack.top.next.value
 		p2 := stack.top.value
 
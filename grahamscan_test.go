@@ -22,3 +22,23 @@ func Example() {
 	// [{0 0} {3 4} {1 2} {20 70} {-4 5}]
 	// [{-4 5} {20 70} {3 4} {0 0}]
 }
+
+// Points that are almost on a line still give a hull that goes through
+// both of the extreme points
+func Example_almostCollinear() {
+	ps := Points{
+		&Point{1.33, 0.811},
+		&Point{0.696, 0.427},
+		&Point{1.205, 0.736},
+		&Point{2.187, 1.331},
+		&Point{3.051, 1.855},
+		&Point{2.560, 1.557},
+	}
+	hull, err := ps.Compute()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(hull)
+	// Output:
+	// [{1.205 0.736} {3.051 1.855} {2.56 1.557} {1.33 0.811} {0.696 0.427}]
+}
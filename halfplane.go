@@ -0,0 +1,142 @@
+package convexhull
+
+import (
+	"errors"
+	"math"
+)
+
+// HalfPlane is the set of points (x, y) where A*x + B*y <= C
+type HalfPlane struct {
+	A, B, C float64
+}
+
+// Contains returns true if p is in the half-plane
+func (h HalfPlane) Contains(p Point) bool {
+	return h.A*p.X+h.B*p.Y <= h.C
+}
+
+// interiorPoint returns a point that is as far inside all the half-planes
+// as possible, up to a distance of 1, and that distance. The half-planes
+// must have normals of length 1. The distance is negative if there is no
+// point in all of them.
+func interiorPoint(hs []HalfPlane) (Point, float64) {
+	// Maximize r in A*x + B*y + r <= C. The variables are split into
+	// positive and negative parts, and r is shifted by m so that the
+	// origin is a feasible start.
+	var m float64
+	for _, h := range hs {
+		m = math.Max(m, -h.C)
+	}
+	a := make([][]float64, 0, len(hs)+1)
+	b := make([]float64, 0, len(hs)+1)
+	for _, h := range hs {
+		a = append(a, []float64{h.A, -h.A, h.B, -h.B, 1})
+		b = append(b, h.C+m)
+	}
+	a = append(a, []float64{0, 0, 0, 0, 1})
+	b = append(b, m+1)
+	z, ok := simplex(a, b, []float64{0, 0, 0, 0, 1})
+	if !ok {
+		return Point{}, -1
+	}
+	return Point{z[0] - z[1], z[2] - z[3]}, z[4] - m
+}
+
+// HalfPlaneIntersection returns the convex polygon where all the half-planes
+// overlap. A point strictly inside all of them is found with a small linear
+// program first. Seen from there, each half-plane n·x <= d is mapped to the
+// dual point n/d, and the edges of the intersection belong to the vertices
+// of the convex hull of the dual points, in the same order. An error is
+// returned if the intersection is empty, has no area or is unbounded.
+func HalfPlaneIntersection(hs []HalfPlane) (ConvexPolygon, error) {
+	normalized, ok := normalizeHalfPlanes(hs)
+	if !ok {
+		return ConvexPolygon{}, errors.New("Intersection is empty")
+	}
+
+	// Rounding errors can make r slightly negative when the intersection
+	// is a single point or a segment
+	const eps = 1e-12
+	o, r := interiorPoint(normalized)
+	if r < -eps {
+		return ConvexPolygon{}, errors.New("Intersection is empty")
+	}
+	if r <= eps {
+		return ConvexPolygon{}, errors.New("Intersection has no area")
+	}
+
+	// The intersection is bounded if the normals point in all directions,
+	// so that the origin is strictly inside their hull
+	normals := make(Points, len(normalized))
+	for i, h := range normalized {
+		normals[i] = &Point{h.A, h.B}
+	}
+	if hull := hullOf(normals); hull.Len() < 3 || hull.Classify(Point{}) != Inside {
+		return ConvexPolygon{}, errors.New("Intersection is unbounded")
+	}
+
+	dual := make(Points, len(normalized))
+	for i, h := range normalized {
+		d := h.C - h.A*o.X - h.B*o.Y
+		dual[i] = &Point{h.A / d, h.B / d}
+	}
+	// Redundant half-planes through a vertex may still show up in the dual
+	// hull because of rounding errors, giving almost equal vertices
+	q := hullOf(dual).vertices
+	vertices := make(Points, 0, len(q))
+	for i := range q {
+		a, b := q[i], q[(i+1)%len(q)]
+		det := a.X*b.Y - a.Y*b.X
+		p := Point{o.X + (b.Y-a.Y)/det, o.Y + (a.X-b.X)/det}
+		if len(vertices) == 0 || !almostEqual(*vertices[len(vertices)-1], p) {
+			vertices = append(vertices, &p)
+		}
+	}
+	cp := hullOf(vertices)
+	if cp.Len() < 3 {
+		return ConvexPolygon{}, errors.New("Intersection has no area")
+	}
+	return cp, nil
+}
+
+// Clip returns the part of the polygon that is inside the half-plane, in
+// O(n). Vertices that are on the boundary line, apart from rounding
+// errors, count as inside, so the result may be a segment or a single
+// point.
+func (cp ConvexPolygon) Clip(h HalfPlane) ConvexPolygon {
+	const eps = 1e-12
+	v := cp.vertices
+	n := len(v)
+
+	// f is positive outside the half-plane
+	f := make([]float64, n)
+	for i, p := range v {
+		f[i] = h.A*p.X + h.B*p.Y - h.C
+		if math.Abs(f[i]) <= eps*(math.Abs(h.A*p.X)+math.Abs(h.B*p.Y)+math.Abs(h.C)) {
+			f[i] = 0
+		}
+	}
+
+	var out []Point
+	add := func(p Point) {
+		if len(out) == 0 || !almostEqual(out[len(out)-1], p) {
+			out = append(out, p)
+		}
+	}
+	for i := range v {
+		j := (i + 1) % n
+		if f[i] <= 0 {
+			add(v[i])
+		}
+		if (f[i] < 0 && f[j] > 0) || (f[i] > 0 && f[j] < 0) {
+			add(lerp(v[i], v[j], f[i]/(f[i]-f[j])))
+		}
+	}
+	for len(out) > 1 && almostEqual(out[0], out[len(out)-1]) {
+		out = out[:len(out)-1]
+	}
+	if len(out) >= 3 {
+		out = removeCollinear(out)
+	}
+	return ConvexPolygon{out}
+}
@@ -0,0 +1,40 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleHalfPlaneIntersection() {
+	// The square from (0, 0) to (2, 2), with one corner cut off, and one
+	// half-plane that does not change anything
+	hs := []HalfPlane{
+		{-1, 0, 0},
+		{1, 0, 2},
+		{0, -1, 0},
+		{0, 1, 2},
+		{1, 1, 3},
+		{1, 1, 10},
+	}
+	cp, err := HalfPlaneIntersection(hs)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%d %.3f\n", cp.Len(), cp.Area())
+
+	// x <= 0 and x >= 1 do not overlap
+	_, err = HalfPlaneIntersection([]HalfPlane{{1, 0, 0}, {-1, 0, -1}, {0, 1, 1}, {0, -1, 1}})
+	fmt.Println(err)
+
+	// Only the point (1, 1) is in all of them
+	_, err = HalfPlaneIntersection([]HalfPlane{{1, 0, 1}, {-1, 0, -1}, {0, 1, 1}, {0, -1, -1}})
+	fmt.Println(err)
+
+	// Nothing bounds y from above
+	_, err = HalfPlaneIntersection([]HalfPlane{{-1, 0, 0}, {1, 0, 2}, {0, -1, 0}})
+	fmt.Println(err)
+	// Output:
+	// 5 3.500
+	// Intersection is empty
+	// Intersection has no area
+	// Intersection is unbounded
+}
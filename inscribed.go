@@ -0,0 +1,284 @@
+package convexhull
+
+import (
+	"math"
+	"sort"
+)
+
+// simplex maximizes c·z subject to a·z <= b and z >= 0, where b >= 0 so
+// that z = 0 is a feasible start. Bland's rule is used to avoid cycling.
+// The returned bool is false if the problem is unbounded.
+func simplex(a [][]float64, b, c []float64) ([]float64, bool) {
+	const eps = 1e-12
+	m, k := len(a), len(c)
+
+	// The tableau has one row per constraint, followed by the objective,
+	// and one column per variable and slack variable, followed by b
+	t := make([][]float64, m+1)
+	for i := range t {
+		t[i] = make([]float64, k+m+1)
+	}
+	basis := make([]int, m)
+	for i := 0; i < m; i++ {
+		copy(t[i], a[i])
+		t[i][k+i] = 1
+		t[i][k+m] = b[i]
+		basis[i] = k + i
+	}
+	for j := 0; j < k; j++ {
+		t[m][j] = -c[j]
+	}
+
+	for {
+		col := -1
+		for j := 0; j < k+m; j++ {
+			if t[m][j] < -eps {
+				col = j
+				break
+			}
+		}
+		if col < 0 {
+			break
+		}
+
+		row := -1
+		var best float64
+		for i := 0; i < m; i++ {
+			if t[i][col] <= eps {
+				continue
+			}
+			ratio := t[i][k+m] / t[i][col]
+			if row < 0 || ratio < best-eps || (ratio <= best+eps && basis[i] < basis[row]) {
+				row, best = i, ratio
+			}
+		}
+		if row < 0 {
+			return nil, false
+		}
+
+		pivot := t[row][col]
+		for j := range t[row] {
+			t[row][j] /= pivot
+		}
+		for i := range t {
+			if i == row || t[i][col] == 0 {
+				continue
+			}
+			f := t[i][col]
+			for j := range t[i] {
+				t[i][j] -= f * t[row][j]
+			}
+		}
+		basis[row] = col
+	}
+
+	z := make([]float64, k)
+	for i, j := range basis {
+		if j < k {
+			z[j] = t[i][k+m]
+		}
+	}
+	return z, true
+}
+
+// MaxInscribedCircle returns the largest circle that fits inside the
+// polygon. The center is the Chebyshev center of the polygon, the point
+// that maximizes the distance to the nearest edge, found as a small linear
+// program.
+func (cp ConvexPolygon) MaxInscribedCircle() (center Point, radius float64) {
+	v := cp.vertices
+	n := len(v)
+	if n < 3 {
+		return cp.Centroid(), 0
+	}
+
+	// Solve for the offset from the centroid, which is strictly inside the
+	// polygon, split into positive and negative parts, and the radius
+	o := cp.Centroid()
+	a := make([][]float64, n)
+	b := make([]float64, n)
+	for i := range v {
+		p, q := v[i], v[(i+1)%n]
+		l := math.Hypot(q.X-p.X, q.Y-p.Y)
+		nx, ny := (q.Y-p.Y)/l, -(q.X-p.X)/l
+		a[i] = []float64{nx, -nx, ny, -ny, 1}
+		b[i] = math.Max(0, nx*(p.X-o.X)+ny*(p.Y-o.Y))
+	}
+	z, ok := simplex(a, b, []float64{0, 0, 0, 0, 1})
+	if !ok {
+		return o, 0
+	}
+	return Point{o.X + z[0] - z[1], o.Y + z[2] - z[3]}, z[4]
+}
+
+// goldenMax returns the x in [lo, hi] that maximizes the unimodal function f
+func goldenMax(lo, hi float64, f func(x float64) float64) (x, fx float64) {
+	const r = 0.6180339887498949
+	a, b := hi-r*(hi-lo), lo+r*(hi-lo)
+	fa, fb := f(a), f(b)
+	for i := 0; i < 80 && hi-lo > 1e-12*(1+math.Abs(lo)+math.Abs(hi)); i++ {
+		if fa < fb {
+			lo, a, fa = a, b, fb
+			b = lo + r*(hi-lo)
+			fb = f(b)
+		} else {
+			hi, b, fb = b, a, fa
+			a = hi - r*(hi-lo)
+			fa = f(a)
+		}
+	}
+	if fa > fb {
+		return a, fa
+	}
+	return b, fb
+}
+
+// maxInscribedAxisRect returns the largest axis aligned rectangle inside
+// the convex polygon with the vertices v, in counter-clockwise order, and
+// its area.
+//
+// A rectangle from y1 to y2 is inside the polygon if its corners are, so
+// its largest width is given by the chords of the polygon at y1 and y2.
+// The logarithm of the area is then concave in y1 and y2, so the maximum
+// is found by nested golden section searches.
+func maxInscribedAxisRect(v []Point) (Rect, float64) {
+	n := len(v)
+	bottom, top := 0, 0
+	for i := range v {
+		if v[i].Y < v[bottom].Y {
+			bottom = i
+		}
+		if v[i].Y > v[top].Y {
+			top = i
+		}
+	}
+
+	// Both chains go from the bottom to the top, with increasing y
+	var left, right []Point
+	for i := bottom; ; i = (i + 1) % n {
+		right = append(right, v[i])
+		if i == top {
+			break
+		}
+	}
+	for i := bottom; ; i = (i + n - 1) % n {
+		left = append(left, v[i])
+		if i == top {
+			break
+		}
+	}
+	xAt := func(ch []Point, y float64) float64 {
+		m := sort.Search(len(ch)-1, func(m int) bool {
+			return ch[m+1].Y >= y
+		})
+		if m >= len(ch)-1 {
+			return ch[len(ch)-1].X
+		}
+		p, q := ch[m], ch[m+1]
+		if q.Y == p.Y {
+			return p.X
+		}
+		return p.X + (y-p.Y)*(q.X-p.X)/(q.Y-p.Y)
+	}
+	span := func(y1, y2 float64) (x1, x2 float64) {
+		x1 = math.Max(xAt(left, y1), xAt(left, y2))
+		x2 = math.Min(xAt(right, y1), xAt(right, y2))
+		return x1, x2
+	}
+
+	minY, maxY := v[bottom].Y, v[top].Y
+	bestY2 := func(y1 float64) (float64, float64) {
+		return goldenMax(y1, maxY, func(y2 float64) float64 {
+			x1, x2 := span(y1, y2)
+			return math.Max(0, x2-x1) * (y2 - y1)
+		})
+	}
+	y1, area := goldenMax(minY, maxY, func(y1 float64) float64 {
+		_, area := bestY2(y1)
+		return area
+	})
+	y2, _ := bestY2(y1)
+	x1, x2 := span(y1, y2)
+	if x2 < x1 {
+		x2 = x1
+	}
+	return Rect{
+		Center: Point{(x1 + x2) / 2, (y1 + y2) / 2},
+		Width:  x2 - x1,
+		Height: y2 - y1,
+	}, area
+}
+
+// MaxInscribedRect returns the largest axis aligned rectangle that fits
+// inside the polygon
+func (cp ConvexPolygon) MaxInscribedRect() Rect {
+	if len(cp.vertices) < 3 {
+		return Rect{Center: cp.Centroid()}
+	}
+	r, _ := maxInscribedAxisRect(cp.vertices)
+	return r
+}
+
+// MaxInscribedOrientedRect returns a rectangle, in any orientation, that
+// fits inside the polygon, with close to the largest area. The result is
+// approximate, and may be slightly smaller than the largest rectangle. The
+// area is not unimodal in the angle, so the angles of all edges and 90
+// evenly spaced angles are tried, and the best of them is refined with a
+// golden section search between its neighbouring angles. For each angle,
+// the largest rectangle is found with nested golden section searches, as
+// for MaxInscribedRect.
+func (cp ConvexPolygon) MaxInscribedOrientedRect() Rect {
+	v := cp.vertices
+	n := len(v)
+	if n < 3 {
+		return Rect{Center: cp.Centroid()}
+	}
+
+	// rotated returns the largest rectangle with the given angle
+	rotated := func(angle float64) (Rect, float64) {
+		s, c := math.Sincos(angle)
+		w := make([]Point, n)
+		for i, p := range v {
+			w[i] = Point{c*p.X + s*p.Y, -s*p.X + c*p.Y}
+		}
+		r, area := maxInscribedAxisRect(w)
+		r.Center = Point{c*r.Center.X - s*r.Center.Y, s*r.Center.X + c*r.Center.Y}
+		r.Angle = angle
+		return r, area
+	}
+
+	// Rectangles repeat every quarter turn
+	const quarter = math.Pi / 2
+	var angles []float64
+	for i := 0; i < 90; i++ {
+		angles = append(angles, quarter*float64(i)/90)
+	}
+	for i := range v {
+		q := v[(i+1)%n]
+		angles = append(angles, math.Mod(math.Atan2(q.Y-v[i].Y, q.X-v[i].X)+2*math.Pi, quarter))
+	}
+	sort.Float64s(angles)
+
+	best, bestArea, bestIndex := Rect{}, -1.0, 0
+	for i, angle := range angles {
+		if r, area := rotated(angle); area > bestArea {
+			best, bestArea, bestIndex = r, area, i
+		}
+	}
+
+	lo, hi := angles[0]-quarter/90, angles[len(angles)-1]+quarter/90
+	if bestIndex > 0 {
+		lo = angles[bestIndex-1]
+	}
+	if bestIndex < len(angles)-1 {
+		hi = angles[bestIndex+1]
+	}
+	angle, area := goldenMax(lo, hi, func(angle float64) float64 {
+		_, area := rotated(angle)
+		return area
+	})
+	if area > bestArea {
+		best, _ = rotated(angle)
+	}
+	return best
+}
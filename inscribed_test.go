@@ -0,0 +1,57 @@
+package convexhull
+
+import (
+	"fmt"
+	"math"
+)
+
+func ExampleConvexPolygon_MaxInscribedCircle() {
+	ps := Points{
+		&Point{0, 0},
+		&Point{4, 0},
+		&Point{4, 2},
+		&Point{0, 2},
+	}
+	cp, err := NewConvexPolygon(ps)
+	if err != nil {
+		panic(err)
+	}
+	center, radius := cp.MaxInscribedCircle()
+	fmt.Println(center.Y, radius)
+	r := cp.MaxInscribedRect()
+	fmt.Printf("%.3f\n", r.Area())
+	// Output:
+	// 1 1
+	// 8.000
+}
+
+func ExampleConvexPolygon_MaxInscribedOrientedRect() {
+	diamond, err := NewConvexPolygon(Points{&Point{1, 0}, &Point{0, 1}, &Point{-1, 0}, &Point{0, -1}})
+	if err != nil {
+		panic(err)
+	}
+	axis := diamond.MaxInscribedRect()
+	oriented := diamond.MaxInscribedOrientedRect()
+	fmt.Printf("%.3f %.3f %.3f\n", axis.Area(), oriented.Area(), math.Mod(oriented.Angle, math.Pi/2))
+
+	// A 4x1 rectangle turned by half a radian
+	s, c := math.Sincos(0.5)
+	var ps Points
+	for _, p := range []Point{{0, 0}, {4, 0}, {4, 1}, {0, 1}} {
+		ps = append(ps, &Point{c*p.X - s*p.Y, s*p.X + c*p.Y})
+	}
+	turned, err := NewConvexPolygon(ps)
+	if err != nil {
+		panic(err)
+	}
+	axis = turned.MaxInscribedRect()
+	oriented = turned.MaxInscribedOrientedRect()
+	inside := true
+	for _, p := range oriented.Corners() {
+		inside = inside && turned.SignedDistance(*p) <= 1e-6
+	}
+	fmt.Printf("%.3f %.3f %v\n", axis.Area(), oriented.Area(), inside)
+	// Output:
+	// 1.000 2.000 0.785
+	// 0.594 4.000 true
+}
@@ -0,0 +1,225 @@
+package convexhull
+
+import (
+	"math"
+)
+
+// Based on ConvexIntersect from Computational Geometry in C by Joseph O'Rourke
+
+// inFlag tells which polygon is currently inside the other one, while
+// walking along the boundaries
+type inFlag int
+
+const (
+	unknownIn inFlag = iota
+	aIn
+	bIn
+)
+
+func sign(x float64) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+// between returns true if c is on the segment from a to b, given that the
+// three points are collinear
+func between(a, b, c Point) bool {
+	if a.X != b.X {
+		return (a.X <= c.X && c.X <= b.X) || (a.X >= c.X && c.X >= b.X)
+	}
+	return (a.Y <= c.Y && c.Y <= b.Y) || (a.Y >= c.Y && c.Y >= b.Y)
+}
+
+// segmentCode tells how two segments intersect
+type segmentCode int
+
+const (
+	segmentNone   segmentCode = iota
+	segmentProper             // the segments cross at a single interior point
+	segmentVertex             // an endpoint of one segment is on the other
+	segmentEdge               // the segments are collinear and overlap
+)
+
+// parallelIntersection intersects the parallel segments from a to b and from
+// c to d. If they overlap, the shared segment goes from p to q.
+func parallelIntersection(a, b, c, d Point) (code segmentCode, p, q Point) {
+	if Area2(a, b, c) != 0 {
+		return segmentNone, p, q
+	}
+	switch {
+	case between(a, b, c) && between(a, b, d):
+		return segmentEdge, c, d
+	case between(c, d, a) && between(c, d, b):
+		return segmentEdge, a, b
+	case between(a, b, c) && between(c, d, b):
+		return segmentEdge, c, b
+	case between(a, b, c) && between(c, d, a):
+		return segmentEdge, c, a
+	case between(a, b, d) && between(c, d, b):
+		return segmentEdge, d, b
+	case between(a, b, d) && between(c, d, a):
+		return segmentEdge, d, a
+	}
+	return segmentNone, p, q
+}
+
+// segmentIntersection intersects the segments from a to b and from c to d.
+// The intersection point is p, or the shared segment from p to q if the
+// segments are collinear. The code is found from the signs of Area2, so
+// that shared endpoints are found exactly.
+func segmentIntersection(a, b, c, d Point) (code segmentCode, p, q Point) {
+	d1, d2 := sign(Area2(c, d, a)), sign(Area2(c, d, b))
+	d3, d4 := sign(Area2(a, b, c)), sign(Area2(a, b, d))
+	switch {
+	case d1 == 0 && d2 == 0 && d3 == 0 && d4 == 0:
+		return parallelIntersection(a, b, c, d)
+	case d1*d2 < 0 && d3*d4 < 0:
+		s := Area2(c, d, a) / (Area2(c, d, a) - Area2(c, d, b))
+		return segmentProper, Point{a.X + s*(b.X-a.X), a.Y + s*(b.Y-a.Y)}, q
+	case d1 == 0 && d3*d4 <= 0 && between(c, d, a):
+		return segmentVertex, a, q
+	case d2 == 0 && d3*d4 <= 0 && between(c, d, b):
+		return segmentVertex, b, q
+	case d3 == 0 && d1*d2 <= 0 && between(a, b, c):
+		return segmentVertex, c, q
+	case d4 == 0 && d1*d2 <= 0 && between(a, b, d):
+		return segmentVertex, d, q
+	}
+	return segmentNone, p, q
+}
+
+// Intersect returns the intersection of two convex polygons, in O(n+m),
+// using the algorithm by O'Rourke, Chien, Olson and Naddor. The edges of
+// the two polygons are advanced in turn, so that they chase each other
+// around the boundary of the intersection. If the polygons only touch, the
+// result has fewer than three vertices, and if they are disjoint the result
+// has no vertices.
+func Intersect(a, b ConvexPolygon) ConvexPolygon {
+	pa, pb := a.vertices, b.vertices
+	n, m := len(pa), len(pb)
+	if n < 3 || m < 3 {
+		return ConvexPolygon{}
+	}
+
+	var out []Point
+	add := func(p Point) {
+		if len(out) == 0 || !almostEqual(out[len(out)-1], p) {
+			out = append(out, p)
+		}
+	}
+
+	i, j := 0, 0   // the current edges end at pa[i] and pb[j]
+	ai, bj := 0, 0 // the number of times each polygon has been advanced
+	flag := unknownIn
+	first := true
+	advanceA := func(inside bool) {
+		if inside {
+			add(pa[i])
+		}
+		ai++
+		i = (i + 1) % n
+	}
+	advanceB := func(inside bool) {
+		if inside {
+			add(pb[j])
+		}
+		bj++
+		j = (j + 1) % m
+	}
+
+	for {
+		i1, j1 := (i+n-1)%n, (j+m-1)%m
+		ax, ay := pa[i].X-pa[i1].X, pa[i].Y-pa[i1].Y
+		bx, by := pb[j].X-pb[j1].X, pb[j].Y-pb[j1].Y
+
+		cross := sign(ax*by - ay*bx)
+		aHB := sign(Area2(pb[j1], pb[j], pa[i]))
+		bHA := sign(Area2(pa[i1], pa[i], pb[j]))
+
+		code, p, q := segmentIntersection(pa[i1], pa[i], pb[j1], pb[j])
+		if code == segmentProper || code == segmentVertex {
+			if flag == unknownIn && first {
+				ai, bj = 0, 0
+				first = false
+			}
+			add(p)
+			if aHB > 0 {
+				flag = aIn
+			} else if bHA > 0 {
+				flag = bIn
+			}
+		}
+
+		switch {
+		case code == segmentEdge && ax*bx+ay*by < 0:
+			// The edges overlap and point in opposite directions, so the
+			// polygons only share this segment
+			return ConvexPolygon{[]Point{p, q}}
+		case cross == 0 && aHB < 0 && bHA < 0:
+			// The edges are parallel and separated, so are the polygons
+			return ConvexPolygon{}
+		case cross == 0 && aHB == 0 && bHA == 0:
+			// The edges are collinear, advance without output
+			if flag == aIn {
+				advanceB(false)
+			} else {
+				advanceA(false)
+			}
+		case cross >= 0:
+			if bHA > 0 {
+				advanceA(flag == aIn)
+			} else {
+				advanceB(flag == bIn)
+			}
+		default:
+			if aHB > 0 {
+				advanceB(flag == bIn)
+			} else {
+				advanceA(flag == aIn)
+			}
+		}
+
+		if !((ai < n || bj < m) && ai < 2*n && bj < 2*m) {
+			break
+		}
+	}
+
+	if flag == unknownIn {
+		// The boundaries do not cross, so one polygon may contain the other
+		if containsAll(b, pa) {
+			return a
+		}
+		if containsAll(a, pb) {
+			return b
+		}
+	}
+
+	for len(out) > 1 && almostEqual(out[0], out[len(out)-1]) {
+		out = out[:len(out)-1]
+	}
+	if len(out) >= 3 {
+		out = removeCollinear(out)
+	}
+	return ConvexPolygon{out}
+}
+
+// almostEqual returns true if p and q are equal, apart from rounding errors
+func almostEqual(p, q Point) bool {
+	const eps = 1e-12
+	return math.Abs(p.X-q.X) <= eps*(1+math.Abs(p.X)) && math.Abs(p.Y-q.Y) <= eps*(1+math.Abs(p.Y))
+}
+
+// containsAll returns true if all the points are inside or on the polygon
+func containsAll(cp ConvexPolygon, ps []Point) bool {
+	for _, p := range ps {
+		if !cp.Contains(p) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,22 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleIntersect() {
+	a, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 4}, &Point{0, 4}})
+	if err != nil {
+		panic(err)
+	}
+	b, err := NewConvexPolygon(Points{&Point{2, 2}, &Point{6, 2}, &Point{6, 6}, &Point{2, 6}})
+	if err != nil {
+		panic(err)
+	}
+	overlap := Intersect(a, b)
+	fmt.Println(overlap.Area())
+	fmt.Println(overlap.Classify(Point{3, 3}))
+	// Output:
+	// 4
+	// inside
+}
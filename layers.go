@@ -0,0 +1,96 @@
+package convexhull
+
+import (
+	"sort"
+)
+
+// peel finds the convex layers by computing the hull of the remaining points
+// over and over, stopping after at most limit layers. It returns the points
+// of each layer, and the layer of each point. Points that are left when it
+// stops have depth limit.
+func peel(ps Points, limit int) ([]Points, []int) {
+	depth := make([]int, len(ps))
+	remaining := make([]int, len(ps))
+	for i := range ps {
+		remaining[i] = i
+	}
+
+	var layers []Points
+	for len(remaining) > 0 && len(layers) < limit {
+		left := make(Points, len(remaining))
+		for i, j := range remaining {
+			left[i] = ps[j]
+		}
+		hull := hullOf(left)
+		v := hull.vertices
+		n := len(v)
+
+		// Points on the boundary are ordered by the edge they are on, and
+		// by how far along the edge they are
+		type position struct {
+			index, edge int
+			t           float64
+		}
+		var layer []position
+		var rest []int
+		for _, j := range remaining {
+			p := *ps[j]
+			if !hull.OnBoundary(p) {
+				rest = append(rest, j)
+				continue
+			}
+			edge := 0
+			for i := range v {
+				if onSegment(v[i], v[(i+1)%n], p) {
+					edge = i
+					break
+				}
+			}
+			a, b := v[edge], v[(edge+1)%n]
+			var t float64
+			if d := (Point{b.X - a.X, b.Y - a.Y}); d != (Point{}) {
+				t = dot(Point{p.X - a.X, p.Y - a.Y}, d) / dot(d, d)
+			}
+			layer = append(layer, position{j, edge, t})
+		}
+		sort.SliceStable(layer, func(i, j int) bool {
+			if layer[i].edge != layer[j].edge {
+				return layer[i].edge < layer[j].edge
+			}
+			return layer[i].t < layer[j].t
+		})
+
+		points := make(Points, len(layer))
+		for i, pos := range layer {
+			points[i] = ps[pos.index]
+			depth[pos.index] = len(layers)
+		}
+		layers = append(layers, points)
+		remaining = rest
+	}
+	for _, j := range remaining {
+		depth[j] = limit
+	}
+	return layers, depth
+}
+
+// ConvexLayers peels the points like an onion. The first layer is the
+// points on the boundary of the convex hull, the second layer is the points
+// on the boundary of the hull of the points that are left, and so on, until
+// no points are left. The points of each layer are in counter-clockwise
+// order along the boundary. The returned points are the given ones, not
+// copies. Each layer is found by computing a new hull, which takes
+// O(n² log n) time in the worst case.
+func ConvexLayers(ps Points) []Points {
+	layers, _ := peel(ps, len(ps))
+	return layers
+}
+
+// LayerDepth returns the convex layer that each of the points is on, as
+// found by ConvexLayers, where 0 is the outermost layer. Points that are
+// deep inside the set have a high depth, which makes the depth a robust
+// measure of how central a point is.
+func LayerDepth(ps Points) []int {
+	_, depth := peel(ps, len(ps))
+	return depth
+}
@@ -0,0 +1,33 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleConvexLayers() {
+	ps := Points{
+		&Point{0, 0}, &Point{2, 0}, &Point{4, 0}, &Point{4, 4}, &Point{0, 4},
+		&Point{1, 1}, &Point{3, 1}, &Point{2, 3},
+		&Point{2, 2},
+	}
+	for _, layer := range ConvexLayers(ps) {
+		fmt.Println(layer)
+	}
+	fmt.Println(len(ConvexLayers(nil)))
+	// Output:
+	// [{0 0} {2 0} {4 0} {4 4} {0 4}]
+	// [{1 1} {3 1} {2 3}]
+	// [{2 2}]
+	// 0
+}
+
+func ExampleLayerDepth() {
+	ps := Points{
+		&Point{0, 0}, &Point{2, 0}, &Point{4, 0}, &Point{4, 4}, &Point{0, 4},
+		&Point{1, 1}, &Point{3, 1}, &Point{2, 3},
+		&Point{2, 2}, &Point{2, 2},
+	}
+	fmt.Println(LayerDepth(ps))
+	// Output:
+	// [0 0 0 0 0 1 1 1 2 2]
+}
@@ -0,0 +1,191 @@
+package convexhull
+
+import (
+	"math"
+	"math/rand"
+)
+
+// LPStatus is the outcome of a linear program
+type LPStatus int
+
+const (
+	Optimal LPStatus = iota
+	Infeasible
+	Unbounded
+)
+
+func (s LPStatus) String() string {
+	switch s {
+	case Infeasible:
+		return "infeasible"
+	case Unbounded:
+		return "unbounded"
+	}
+	return "optimal"
+}
+
+// normalizeHalfPlanes scales the half-planes so that their normals have
+// length 1. Half-planes without a normal hold everywhere or nowhere, so they
+// are left out, and false is returned if one of them holds nowhere.
+func normalizeHalfPlanes(hs []HalfPlane) ([]HalfPlane, bool) {
+	normalized := make([]HalfPlane, 0, len(hs))
+	for _, h := range hs {
+		l := math.Hypot(h.A, h.B)
+		if l == 0 {
+			if h.C < 0 {
+				return nil, false
+			}
+			continue
+		}
+		normalized = append(normalized, HalfPlane{h.A / l, h.B / l, h.C / l})
+	}
+	return normalized, true
+}
+
+// boundary returns a point on the boundary line of the half-plane, and the
+// direction of the line
+func (h HalfPlane) boundary() (p, dir Point) {
+	l := h.A*h.A + h.B*h.B
+	return Point{h.A * h.C / l, h.B * h.C / l}, Point{-h.B, h.A}
+}
+
+// lineIntersection returns the point where the boundary lines of two
+// half-planes cross. The lines must not be parallel.
+func lineIntersection(g, h HalfPlane) Point {
+	det := g.A*h.B - g.B*h.A
+	return Point{(g.C*h.B - g.B*h.C) / det, (g.A*h.C - g.C*h.A) / det}
+}
+
+// interval returns the range of t for which p + t*dir is in all the
+// half-planes. The range is empty if lo > hi.
+func interval(hs []HalfPlane, p, dir Point) (lo, hi float64) {
+	const eps = 1e-12
+	lo, hi = math.Inf(-1), math.Inf(1)
+	for _, h := range hs {
+		a := h.A*p.X + h.B*p.Y
+		b := h.A*dir.X + h.B*dir.Y
+		switch {
+		case b > 0:
+			hi = math.Min(hi, (h.C-a)/b)
+		case b < 0:
+			lo = math.Max(lo, (h.C-a)/b)
+		case a > h.C+eps*(1+math.Abs(h.C)):
+			return math.Inf(1), math.Inf(-1)
+		}
+	}
+	// Rounding errors may leave an empty range where the half-planes meet
+	// in a single point
+	if lo > hi && lo-hi <= eps*(1+math.Abs(lo)+math.Abs(hi)) {
+		lo = (lo + hi) / 2
+		hi = lo
+	}
+	return lo, hi
+}
+
+// LinearProgram maximizes c·x for the points x that are in all the
+// half-planes, using Seidel's randomized incremental algorithm.
+func LinearProgram(hs []HalfPlane, c Point) (x Point, status LPStatus) {
+	return LinearProgramSeed(hs, c, 1)
+}
+
+// LinearProgramSeed maximizes c·x for the points x that are in all the
+// half-planes, using Seidel's randomized incremental algorithm in expected
+// O(n) time. The seed is used for shuffling the half-planes. If the optimum
+// is not unique, any optimal point may be returned. If the objective is
+// unbounded, x is a point in all the half-planes. If there is no such
+// point, the status is Infeasible.
+//
+// First, two half-planes that bound the objective on their own are found,
+// or it is found to be unbounded. Their corner is the optimum for those
+// two. The other half-planes are then added in random order. When the
+// optimum so far is outside the new half-plane, the new optimum is on its
+// boundary line, and is found by a one-dimensional linear program.
+func LinearProgramSeed(hs []HalfPlane, c Point, seed int64) (x Point, status LPStatus) {
+	hs, ok := normalizeHalfPlanes(hs)
+	if !ok {
+		return x, Infeasible
+	}
+
+	var start []int
+	if c != (Point{}) {
+		// The objective is unbounded if there is a direction d = u + t*v
+		// with c·d > 0 that goes further into all the half-planes
+		u := unit(c)
+		v := Point{-u.Y, u.X}
+		bounded := false
+		lo, hi := math.Inf(-1), math.Inf(1)
+		var ilo, ihi int
+		for i, h := range hs {
+			a := h.A*u.X + h.B*u.Y
+			b := h.A*v.X + h.B*v.Y
+			switch {
+			case b > 0 && -a/b < hi:
+				hi, ihi = -a/b, i
+			case b < 0 && -a/b > lo:
+				lo, ilo = -a/b, i
+			case b == 0 && a > 0:
+				// The boundary line is at a right angle to c
+				start, bounded = []int{i}, true
+				x, _ = h.boundary()
+			}
+			if bounded {
+				break
+			}
+		}
+		if !bounded && lo > hi {
+			start, bounded = []int{ilo, ihi}, true
+			x = lineIntersection(hs[ilo], hs[ihi])
+		}
+		if !bounded {
+			x, status = LinearProgramSeed(hs, Point{}, seed)
+			if status == Infeasible {
+				return x, Infeasible
+			}
+			return x, Unbounded
+		}
+	}
+
+	order := make([]HalfPlane, 0, len(hs))
+	for _, i := range start {
+		order = append(order, hs[i])
+	}
+	rest := make([]HalfPlane, 0, len(hs))
+	for i, h := range hs {
+		if len(start) == 0 || (i != start[0] && i != start[len(start)-1]) {
+			rest = append(rest, h)
+		}
+	}
+	r := rand.New(rand.NewSource(seed))
+	r.Shuffle(len(rest), func(i, j int) {
+		rest[i], rest[j] = rest[j], rest[i]
+	})
+	order = append(order, rest...)
+
+	for i := len(start); i < len(order); i++ {
+		h := order[i]
+		if h.Contains(x) {
+			continue
+		}
+		p, dir := h.boundary()
+		lo, hi := interval(order[:i], p, dir)
+		if lo > hi {
+			return Point{}, Infeasible
+		}
+		var t float64
+		switch slope := dot(c, dir); {
+		case slope > 0:
+			t = hi
+		case slope < 0:
+			t = lo
+		default:
+			// Every point on the line is as good, so take the one
+			// closest to the optimum so far
+			t = math.Max(lo, math.Min(hi, dot(Point{x.X - p.X, x.Y - p.Y}, dir)/dot(dir, dir)))
+		}
+		if math.IsInf(t, 0) {
+			return x, Unbounded
+		}
+		x = Point{p.X + t*dir.X, p.Y + t*dir.Y}
+	}
+	return x, Optimal
+}
@@ -0,0 +1,17 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleLinearProgram() {
+	// x + y <= 4, x <= 3, y >= 0 and x >= 0
+	constraints := []HalfPlane{{1, 1, 4}, {1, 0, 3}, {0, -1, 0}, {-1, 0, 0}}
+	fmt.Println(LinearProgram(constraints, Point{2, 1}))
+	fmt.Println(LinearProgram(constraints[1:], Point{2, 1}))
+	fmt.Println(LinearProgram(append(constraints, HalfPlane{0, -1, -5}), Point{2, 1}))
+	// Output:
+	// {3 1} optimal
+	// {0 0} unbounded
+	// {0 0} infeasible
+}
@@ -0,0 +1,101 @@
+package convexhull
+
+import (
+	"math"
+)
+
+// sum is a Neumaier (improved Kahan) summation, which keeps track of the
+// low order bits that are lost when adding numbers of different magnitude
+type sum struct {
+	s, c float64
+}
+
+func (k *sum) Add(x float64) {
+	t := k.s + x
+	if math.Abs(k.s) >= math.Abs(x) {
+		k.c += (k.s - t) + x
+	} else {
+		k.c += (x - t) + k.s
+	}
+	k.s = t
+}
+
+func (k *sum) Value() float64 {
+	return k.s + k.c
+}
+
+// SignedArea returns the area of the polygon formed by the points, using the
+// shoelace formula. The area is positive if the points are in
+// counter-clockwise order and negative if they are in clockwise order.
+func (ps Points) SignedArea() float64 {
+	if len(ps) < 3 {
+		return 0
+	}
+	var area sum
+	for i := 1; i < len(ps)-1; i++ {
+		area.Add(Area2(*ps[0], *ps[i], *ps[i+1]))
+	}
+	return area.Value() / 2
+}
+
+// Area returns the area of the polygon formed by the points
+func (ps Points) Area() float64 {
+	return math.Abs(ps.SignedArea())
+}
+
+// Perimeter returns the length of the closed polygon formed by the points
+func (ps Points) Perimeter() float64 {
+	if len(ps) < 2 {
+		return 0
+	}
+	var length sum
+	for i := range ps {
+		j := (i + 1) % len(ps)
+		length.Add(math.Hypot(ps[j].X-ps[i].X, ps[j].Y-ps[i].Y))
+	}
+	return length.Value()
+}
+
+// Centroid returns the center of mass of the polygon formed by the points.
+// If the polygon has no area, the average of the points is returned.
+func (ps Points) Centroid() Point {
+	if len(ps) == 0 {
+		return Point{}
+	}
+
+	// Sum over the triangles of the fan from the first point, relative to
+	// the first point to keep the numbers small
+	p0 := *ps[0]
+	var area, x, y sum
+	for i := 1; i < len(ps)-1; i++ {
+		a := Area2(p0, *ps[i], *ps[i+1])
+		area.Add(a)
+		x.Add(a * (ps[i].X + ps[i+1].X - 2*p0.X))
+		y.Add(a * (ps[i].Y + ps[i+1].Y - 2*p0.Y))
+	}
+	if area.Value() == 0 {
+		var x, y sum
+		for _, p := range ps {
+			x.Add(p.X)
+			y.Add(p.Y)
+		}
+		n := float64(len(ps))
+		return Point{x.Value() / n, y.Value() / n}
+	}
+	return Point{p0.X + x.Value()/(3*area.Value()), p0.Y + y.Value()/(3*area.Value())}
+}
+
+// Area returns the area of the polygon
+func (cp ConvexPolygon) Area() float64 {
+	return cp.Vertices().Area()
+}
+
+// Perimeter returns the length of the boundary of the polygon
+func (cp ConvexPolygon) Perimeter() float64 {
+	return cp.Vertices().Perimeter()
+}
+
+// Centroid returns the center of mass of the polygon
+func (cp ConvexPolygon) Centroid() Point {
+	return cp.Vertices().Centroid()
+}
@@ -0,0 +1,29 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExamplePoints_SignedArea() {
+	ps := Points{
+		&Point{0, 0},
+		&Point{4, 0},
+		&Point{4, 2},
+		&Point{0, 2},
+		&Point{1, 1},
+	}
+	hull, err := ps.Compute()
+	if err != nil {
+		panic(err)
+	}
+	// Compute returns the hull in clockwise order
+	fmt.Println(hull.SignedArea())
+	fmt.Println(hull.Area())
+	fmt.Println(hull.Perimeter())
+	fmt.Println(hull.Centroid())
+	// Output:
+	// -8
+	// 8
+	// 12
+	// {2 1}
+}
@@ -0,0 +1,123 @@
+package convexhull
+
+// lowestLeftmost returns the index of the lowest vertex, and of the
+// leftmost one if several are equally low. Going counter-clockwise from
+// there, the angles of the edges increase from 0 towards 2π.
+func lowestLeftmost(vs []Point) int {
+	m := 0
+	for i := 1; i < len(vs); i++ {
+		if vs[i].Y < vs[m].Y || (vs[i].Y == vs[m].Y && vs[i].X < vs[m].X) {
+			m = i
+		}
+	}
+	return m
+}
+
+// upperHalf returns true if the angle of the vector is in [0, π)
+func upperHalf(p Point) bool {
+	return p.Y > 0 || (p.Y == 0 && p.X > 0)
+}
+
+// lessAngle returns true if the angle of the vector a, in [0, 2π), is smaller
+// than the angle of b. Within a half turn the ordering is the same as for
+// sorting points around a pivot.
+func lessAngle(a, b Point) bool {
+	if upperHalf(a) != upperHalf(b) {
+		return upperHalf(a)
+	}
+	return lessAround(Point{}, a, b)
+}
+
+// minkowskiPairs merges the edges of two convex polygons by angle, in
+// O(n+m). It returns the pairs of vertex indices whose sums are the vertices
+// of the Minkowski sum, in counter-clockwise order.
+func minkowskiPairs(pa, pb []Point) [][2]int {
+	n, m := len(pa), len(pb)
+	ia, ib := lowestLeftmost(pa), lowestLeftmost(pb)
+
+	// edge returns the k-th edge, counting from the lowest vertex
+	edge := func(vs []Point, start, k int) Point {
+		p, q := vs[(start+k)%len(vs)], vs[(start+k+1)%len(vs)]
+		return Point{q.X - p.X, q.Y - p.Y}
+	}
+	// A single point has no edges
+	edgesA, edgesB := n, m
+	if n == 1 {
+		edgesA = 0
+	}
+	if m == 1 {
+		edgesB = 0
+	}
+
+	pairs := make([][2]int, 0, n+m)
+	i, j := 0, 0
+	for {
+		pairs = append(pairs, [2]int{(ia + i) % n, (ib + j) % m})
+		switch {
+		case i >= edgesA && j >= edgesB:
+			return pairs[: len(pairs)-1 : len(pairs)-1]
+		case j >= edgesB:
+			i++
+		case i >= edgesA:
+			j++
+		default:
+			ea, eb := edge(pa, ia, i), edge(pb, ib, j)
+			switch {
+			case lessAngle(ea, eb):
+				i++
+			case lessAngle(eb, ea):
+				j++
+			default:
+				// Equal edges are combined into one
+				i++
+				j++
+			}
+		}
+	}
+}
+
+// MinkowskiSum returns the Minkowski sum of two convex polygons, the set of
+// all p + q where p is in a and q is in b. The edges of both polygons are
+// already sorted by angle, so they are merged in O(n+m).
+func MinkowskiSum(a, b ConvexPolygon) ConvexPolygon {
+	pa, pb := a.vertices, b.vertices
+	if len(pa) == 0 || len(pb) == 0 {
+		return ConvexPolygon{}
+	}
+	pairs := minkowskiPairs(pa, pb)
+	out := make([]Point, 0, len(pairs))
+	for _, pair := range pairs {
+		p, q := pa[pair[0]], pb[pair[1]]
+		out = append(out, Point{p.X + q.X, p.Y + q.Y})
+	}
+	if len(out) == 0 {
+		out = append(out, Point{pa[0].X + pb[0].X, pa[0].Y + pb[0].Y})
+	}
+	if len(out) >= 3 {
+		if vs := removeCollinear(out); len(vs) >= 3 {
+			out = vs
+		} else {
+			// The sum of parallel segments is a segment, and removing
+			// collinear vertices would leave nothing, so keep the ends
+			out = hullOf(ConvexPolygon{out}.Vertices()).vertices
+		}
+	}
+	return ConvexPolygon{out}
+}
+
+// mirror returns the vertices mirrored through the origin
+func mirror(vs []Point) []Point {
+	mirrored := make([]Point, len(vs))
+	for i, p := range vs {
+		mirrored[i] = Point{-p.X, -p.Y}
+	}
+	return mirrored
+}
+
+// MinkowskiDifference returns the Minkowski difference of two convex
+// polygons, the set of all p - q where p is in a and q is in b. This is
+// the sum of a and b mirrored through the origin. The polygons overlap if
+// and only if the difference contains the origin.
+func MinkowskiDifference(a, b ConvexPolygon) ConvexPolygon {
+	return MinkowskiSum(a, ConvexPolygon{mirror(b.vertices)})
+}
@@ -0,0 +1,88 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleMinkowskiSum() {
+	square, err := NewConvexPolygon(Points{
+		&Point{0, 0},
+		&Point{1, 0},
+		&Point{1, 1},
+		&Point{0, 1},
+	})
+	if err != nil {
+		panic(err)
+	}
+	triangle, err := NewConvexPolygon(Points{
+		&Point{0, 0},
+		&Point{2, 0},
+		&Point{0, 2},
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(MinkowskiSum(square, triangle).Vertices())
+
+	// Clipping a triangle along one of its edges leaves a segment, and the
+	// sum of two parallel segments is also a segment
+	a, err := NewConvexPolygon(Points{
+		&Point{0, 3},
+		&Point{2, 5},
+		&Point{0, 5},
+	})
+	if err != nil {
+		panic(err)
+	}
+	b, err := NewConvexPolygon(Points{
+		&Point{5, 0},
+		&Point{6, 1},
+		&Point{6, 0},
+	})
+	if err != nil {
+		panic(err)
+	}
+	a = a.Clip(HalfPlane{-1, 1, 3})
+	b = b.Clip(HalfPlane{1, -1, 5})
+	fmt.Println(a.Vertices(), b.Vertices())
+	fmt.Println(MinkowskiSum(a, b).Vertices())
+	// Output:
+	// [{0 0} {3 0} {3 1} {1 3} {0 3}]
+	// [{0 3} {2 5}] [{6 1} {5 0}]
+	// [{5 3} {8 6}]
+}
+
+func ExampleMinkowskiDifference() {
+	square, err := NewConvexPolygon(Points{
+		&Point{0, 0},
+		&Point{2, 0},
+		&Point{2, 2},
+		&Point{0, 2},
+	})
+	if err != nil {
+		panic(err)
+	}
+	overlapping, err := NewConvexPolygon(Points{
+		&Point{1, 1},
+		&Point{3, 1},
+		&Point{3, 3},
+	})
+	if err != nil {
+		panic(err)
+	}
+	disjoint, err := NewConvexPolygon(Points{
+		&Point{5, 1},
+		&Point{7, 0},
+		&Point{7, 3},
+	})
+	if err != nil {
+		panic(err)
+	}
+	for _, b := range []ConvexPolygon{overlapping, disjoint} {
+		diff := MinkowskiDifference(square, b)
+		fmt.Println(diff.Vertices(), diff.Contains(Point{}))
+	}
+	// Output:
+	// [{-3 -3} {-1 -3} {1 -1} {1 1} {-3 1}] true
+	// [{-7 -3} {-5 -3} {-3 -1} {-3 1} {-5 2} {-7 2}] false
+}
@@ -0,0 +1,126 @@
+package convexhull
+
+import (
+	"math"
+)
+
+// Rect is an oriented rectangle. Width is measured along the direction
+// given by Angle, in radians, and Height is measured perpendicular to it.
+type Rect struct {
+	Center        Point
+	Width, Height float64
+	Angle         float64
+}
+
+// Area returns the area of the rectangle
+func (r Rect) Area() float64 {
+	return r.Width * r.Height
+}
+
+// Perimeter returns the length of the boundary of the rectangle
+func (r Rect) Perimeter() float64 {
+	return 2 * (r.Width + r.Height)
+}
+
+// Corners returns the four corners of the rectangle, in counter-clockwise order
+func (r Rect) Corners() Points {
+	ux, uy := math.Cos(r.Angle)*r.Width/2, math.Sin(r.Angle)*r.Width/2
+	vx, vy := -math.Sin(r.Angle)*r.Height/2, math.Cos(r.Angle)*r.Height/2
+	c := r.Center
+	return Points{
+		New(c.X-ux-vx, c.Y-uy-vy),
+		New(c.X+ux-vx, c.Y+uy-vy),
+		New(c.X+ux+vx, c.Y+uy+vy),
+		New(c.X-ux+vx, c.Y-uy+vy),
+	}
+}
+
+// edgeRects calls fn with the smallest enclosing rectangle that has a side
+// along each edge of the polygon. One of these rectangles has the smallest
+// area, and one has the smallest perimeter. Three calipers follow the
+// extreme vertices in the directions along and across the edge, so this
+// runs in O(n).
+func (cp ConvexPolygon) edgeRects(fn func(r Rect)) {
+	v := cp.vertices
+	n := len(v)
+	switch n {
+	case 0:
+		return
+	case 1:
+		fn(Rect{Center: v[0]})
+		return
+	case 2:
+		dx, dy := v[1].X-v[0].X, v[1].Y-v[0].Y
+		fn(Rect{
+			Center: Point{(v[0].X + v[1].X) / 2, (v[0].Y + v[1].Y) / 2},
+			Width:  math.Hypot(dx, dy),
+			Angle:  math.Atan2(dy, dx),
+		})
+		return
+	}
+
+	right := 1
+	var far, left int
+	for i := 0; i < n; i++ {
+		a, b := v[i], v[(i+1)%n]
+		l := math.Hypot(b.X-a.X, b.Y-a.Y)
+		ux, uy := (b.X-a.X)/l, (b.Y-a.Y)/l
+		along := func(k int) float64 {
+			return (v[k].X-a.X)*ux + (v[k].Y-a.Y)*uy
+		}
+		across := func(k int) float64 {
+			return (v[k].Y-a.Y)*ux - (v[k].X-a.X)*uy
+		}
+
+		for along((right+1)%n) > along(right) {
+			right = (right + 1) % n
+		}
+		if i == 0 {
+			far = right
+		}
+		for across((far+1)%n) > across(far) {
+			far = (far + 1) % n
+		}
+		if i == 0 {
+			left = far
+		}
+		for along((left+1)%n) < along(left) {
+			left = (left + 1) % n
+		}
+
+		minU, maxU, h := along(left), along(right), across(far)
+		mid := (minU + maxU) / 2
+		fn(Rect{
+			Center: Point{a.X + ux*mid - uy*h/2, a.Y + uy*mid + ux*h/2},
+			Width:  maxU - minU,
+			Height: h,
+			Angle:  math.Atan2(uy, ux),
+		})
+	}
+}
+
+// MinAreaRect returns the oriented rectangle with the smallest area that
+// encloses the polygon
+func (cp ConvexPolygon) MinAreaRect() Rect {
+	var best Rect
+	first := true
+	cp.edgeRects(func(r Rect) {
+		if first || r.Area() < best.Area() {
+			best, first = r, false
+		}
+	})
+	return best
+}
+
+// MinPerimeterRect returns the oriented rectangle with the smallest
+// perimeter that encloses the polygon
+func (cp ConvexPolygon) MinPerimeterRect() Rect {
+	var best Rect
+	first := true
+	cp.edgeRects(func(r Rect) {
+		if first || r.Perimeter() < best.Perimeter() {
+			best, first = r, false
+		}
+	})
+	return best
+}
@@ -0,0 +1,42 @@
+package convexhull
+
+import (
+	"fmt"
+	"math"
+)
+
+func ExampleConvexPolygon_MinAreaRect() {
+	// A square rotated by 45 degrees
+	ps := Points{
+		&Point{0, -1},
+		&Point{1, 0},
+		&Point{0, 1},
+		&Point{-1, 0},
+	}
+	hull, err := ps.Compute()
+	if err != nil {
+		panic(err)
+	}
+	cp, err := NewConvexPolygon(hull)
+	if err != nil {
+		panic(err)
+	}
+	r := cp.MinAreaRect()
+	fmt.Printf("%.3f %.3f %.3f\n", r.Area(), r.Width, r.Height)
+	fmt.Printf("%.3f\n", cp.MinPerimeterRect().Perimeter())
+
+	// The corners are the corners of the square, counter-clockwise
+	var corners Points
+	for _, c := range r.Corners() {
+		for _, p := range ps {
+			if math.Hypot(c.X-p.X, c.Y-p.Y) < 1e-9 {
+				corners = append(corners, p)
+			}
+		}
+	}
+	fmt.Println(corners)
+	// Output:
+	// 2.000 1.414 1.414
+	// 5.657
+	// [{0 -1} {1 0} {0 1} {-1 0}]
+}
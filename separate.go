@@ -0,0 +1,57 @@
+package convexhull
+
+// separatingEdge returns true and a line along an edge of a, with a to its
+// left and b strictly to its right, if there is such an edge
+func separatingEdge(a, b ConvexPolygon) (Line, bool) {
+	va := a.vertices
+	n := len(va)
+	for i := range va {
+		p, q := va[i], va[(i+1)%n]
+		d := Point{q.X - p.X, q.Y - p.Y}
+
+		// The outward normal of a counter-clockwise edge points right
+		normal := Point{d.Y, -d.X}
+		limit := dot(normal, p)
+		separated := true
+		for _, r := range b.vertices {
+			if dot(normal, r) <= limit {
+				separated = false
+				break
+			}
+		}
+		if separated {
+			return Line{p, d}, true
+		}
+	}
+	return Line{}, false
+}
+
+// SeparatingAxis tests if two convex polygons overlap, with the Separating
+// Axis Theorem. The polygons are disjoint if and only if their projections
+// onto the normal of one of the edges are disjoint. If so, overlap is false
+// and sep is the line along that edge, with a to its left and b to its
+// right, so one of the polygons touches the line. Polygons that touch each
+// other are counted as overlapping. This runs in O(n·m).
+func SeparatingAxis(a, b ConvexPolygon) (overlap bool, sep Line) {
+	if l, ok := separatingEdge(a, b); ok {
+		return false, l
+	}
+	if l, ok := separatingEdge(b, a); ok {
+		// Turn the line around, so that a is to the left
+		return false, Line{l.P, Point{-l.Dir.X, -l.Dir.Y}}
+	}
+	return true, Line{}
+}
+
+// MaxMarginLine returns the line that separates two labeled sets of points
+// with the largest margin, as a hard-margin linear support vector machine
+// would. The line is the perpendicular bisector of the closest pair of
+// points of the two convex hulls. The points in ps are to the left of the
+// line, and the points in qs to the right. If the sets can not be
+// separated, false is returned.
+func MaxMarginLine(ps, qs Points) (Line, bool) {
+	if len(ps) == 0 || len(qs) == 0 {
+		return Line{}, false
+	}
+	return SeparatingLine(hullOf(ps), hullOf(qs))
+}
@@ -0,0 +1,61 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleSeparatingAxis() {
+	square, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{2, 0}, &Point{2, 2}, &Point{0, 2}})
+	if err != nil {
+		panic(err)
+	}
+	overlapping, err := NewConvexPolygon(Points{&Point{1, 1}, &Point{3, 1}, &Point{3, 3}})
+	if err != nil {
+		panic(err)
+	}
+	touching, err := NewConvexPolygon(Points{&Point{2, 1}, &Point{4, 0}, &Point{4, 2}})
+	if err != nil {
+		panic(err)
+	}
+	disjoint, err := NewConvexPolygon(Points{&Point{5, 1}, &Point{7, 0}, &Point{7, 3}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(SeparatingAxis(square, overlapping))
+	fmt.Println(SeparatingAxis(square, touching))
+	fmt.Println(SeparatingAxis(square, disjoint))
+	fmt.Println(SeparatingAxis(disjoint, square))
+	// Output:
+	// true {{0 0} {0 0}}
+	// true {{0 0} {0 0}}
+	// false {{2 0} {0 2}}
+	// false {{5 1} {2 -1}}
+}
+
+func ExampleMaxMarginLine() {
+	ps := Points{&Point{0, 0}, &Point{2, 0}, &Point{2, 2}, &Point{0, 2}, &Point{1, 1}}
+	overlapping := Points{&Point{1, 1}, &Point{3, 1}, &Point{3, 3}}
+	touching := Points{&Point{2, 2}, &Point{4, 2}, &Point{4, 4}}
+	disjoint := Points{&Point{4, 0}, &Point{4, 2}, &Point{6, 1}}
+	for _, qs := range []Points{overlapping, touching, disjoint, nil} {
+		line, ok := MaxMarginLine(ps, qs)
+		if !ok {
+			fmt.Println("not separable")
+			continue
+		}
+		q := Point{line.P.X + line.Dir.X, line.P.Y + line.Dir.Y}
+		separated := true
+		for _, p := range ps {
+			separated = separated && Area2(line.P, q, *p) > 0
+		}
+		for _, p := range qs {
+			separated = separated && Area2(line.P, q, *p) < 0
+		}
+		fmt.Println(line.P, separated)
+	}
+	// Output:
+	// not separable
+	// not separable
+	// {3 1} true
+	// not separable
+}
@@ -0,0 +1,109 @@
+package convexhull
+
+import (
+	"sort"
+)
+
+// Direction is the corner that points are better towards, when comparing
+// points by dominance
+type Direction int
+
+const (
+	// UpperRight prefers large X and large Y
+	UpperRight Direction = iota
+	// UpperLeft prefers small X and large Y
+	UpperLeft
+	// LowerLeft prefers small X and small Y, like cost and latency
+	LowerLeft
+	// LowerRight prefers large X and small Y
+	LowerRight
+)
+
+func (d Direction) String() string {
+	switch d {
+	case UpperLeft:
+		return "upper left"
+	case LowerLeft:
+		return "lower left"
+	case LowerRight:
+		return "lower right"
+	}
+	return "upper right"
+}
+
+// signs returns the factors that turn the coordinates into ones where
+// larger is better
+func (d Direction) signs() (sx, sy float64) {
+	sx, sy = 1, 1
+	if d == UpperLeft || d == LowerLeft {
+		sx = -1
+	}
+	if d == LowerLeft || d == LowerRight {
+		sy = -1
+	}
+	return sx, sy
+}
+
+// Skyline returns the points that are not dominated by any other point,
+// the Pareto frontier. A point dominates another if it is at least as good
+// in both coordinates, and better in one of them, where the direction says
+// what is better. The points form a staircase, and are returned sorted by X.
+// Of several equal points, only one is kept. The returned points are the
+// given ones, not copies, and the given slice is not changed. This takes
+// O(n log n).
+func Skyline(ps Points, dominance Direction) Points {
+	sx, sy := dominance.signs()
+	sorted := make(Points, len(ps))
+	copy(sorted, ps)
+
+	// Going from the best X to the worst, a point is on the frontier if its
+	// Y is better than for all the points before it
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.X != b.X {
+			return sx*a.X > sx*b.X
+		}
+		return sy*a.Y > sy*b.Y
+	})
+	var ret Points
+	for _, p := range sorted {
+		if len(ret) == 0 || sy*p.Y > sy*ret[len(ret)-1].Y {
+			ret = append(ret, p)
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].X < ret[j].X
+	})
+	return ret
+}
+
+// ConvexSkyline returns the points of the skyline that are on the convex
+// part of the frontier, where the staircase meets the convex hull of the
+// points, sorted by X. Points on the straight line between two others are
+// left out.
+func ConvexSkyline(ps Points, dominance Direction) Points {
+	sx, sy := dominance.signs()
+	sky := Skyline(ps, dominance)
+
+	// With larger being better in both coordinates, the frontier is the
+	// upper hull, where every turn is to the right
+	sort.Slice(sky, func(i, j int) bool {
+		return sx*sky[i].X < sx*sky[j].X
+	})
+	flip := func(p *Point) Point {
+		return Point{sx * p.X, sy * p.Y}
+	}
+	var ret Points
+	for _, p := range sky {
+		for len(ret) >= 2 && Area2(flip(ret[len(ret)-2]), flip(ret[len(ret)-1]), flip(p)) >= 0 {
+			ret = ret[:len(ret)-1]
+		}
+		ret = append(ret, p)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].X < ret[j].X
+	})
+	return ret
+}
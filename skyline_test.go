@@ -0,0 +1,22 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleSkyline() {
+	// Cost and latency of some experiments, where lower is better for both
+	ps := Points{
+		&Point{1, 9},
+		&Point{2, 4},
+		&Point{3, 5},
+		&Point{4, 3},
+		&Point{6, 2.5},
+		&Point{8, 1},
+	}
+	fmt.Println(Skyline(ps, LowerLeft))
+	fmt.Println(ConvexSkyline(ps, LowerLeft))
+	// Output:
+	// [{1 9} {2 4} {4 3} {6 2.5} {8 1}]
+	// [{1 9} {2 4} {8 1}]
+}
@@ -0,0 +1,149 @@
+package convexhull
+
+import (
+	"errors"
+	"math"
+)
+
+// vec3 is a point in three dimensions, used for unit vectors on the sphere
+type vec3 struct {
+	x, y, z float64
+}
+
+func (a vec3) dot(b vec3) float64 {
+	return a.x*b.x + a.y*b.y + a.z*b.z
+}
+
+func (a vec3) cross(b vec3) vec3 {
+	return vec3{a.y*b.z - a.z*b.y, a.z*b.x - a.x*b.z, a.x*b.y - a.y*b.x}
+}
+
+func (a vec3) add(b vec3) vec3 {
+	return vec3{a.x + b.x, a.y + b.y, a.z + b.z}
+}
+
+func (a vec3) scale(s float64) vec3 {
+	return vec3{a.x * s, a.y * s, a.z * s}
+}
+
+func (a vec3) norm() float64 {
+	return math.Sqrt(a.dot(a))
+}
+
+// lonLatToVec3 converts a longitude/latitude pair in degrees to a unit vector
+func lonLatToVec3(lon, lat float64) vec3 {
+	lon *= math.Pi / 180
+	lat *= math.Pi / 180
+	return vec3{math.Cos(lat) * math.Cos(lon), math.Cos(lat) * math.Sin(lon), math.Sin(lat)}
+}
+
+// vec3ToLonLat converts a vector to a longitude/latitude pair in degrees
+func vec3ToLonLat(v vec3) (lon, lat float64) {
+	v = v.scale(1 / v.norm())
+	lat = math.Asin(math.Max(-1, math.Min(1, v.z))) * 180 / math.Pi
+	lon = math.Atan2(v.y, v.x) * 180 / math.Pi
+	if lon == -180 {
+		// Points on the antimeridian are given with the longitude 180
+		lon = 180
+	}
+	return lon, lat
+}
+
+// hemisphereCenter returns a unit vector c with v·c > 0 for all the given
+// unit vectors, apart from a small margin, if they fit in an open
+// hemisphere. If the mean direction does not work, the point of the convex
+// hull of the vectors that is closest to the origin is approached with
+// Gilbert's algorithm. That point gives the direction where the smallest
+// v·c is the largest, so no other direction works if it does not.
+func hemisphereCenter(vs []vec3) (vec3, bool) {
+	const margin = 1e-9
+	var x vec3
+	for _, v := range vs {
+		x = x.add(v)
+	}
+	x = x.scale(1 / float64(len(vs)))
+
+	for iteration := 0; iteration < 10000; iteration++ {
+		n := x.norm()
+		if n < 1e-12 {
+			// The origin is in the hull
+			return vec3{}, false
+		}
+		c := x.scale(1 / n)
+		s := vs[0]
+		for _, v := range vs[1:] {
+			if v.dot(c) < s.dot(c) {
+				s = v
+			}
+		}
+		if s.dot(c) >= margin {
+			return c, true
+		}
+
+		// Move x to the point closest to the origin on the segment to s
+		d := s.add(x.scale(-1))
+		t := -x.dot(d) / d.dot(d)
+		if !(t > 0) {
+			// x can not get closer, so the margin is too small
+			return vec3{}, false
+		}
+		x = x.add(d.scale(math.Min(t, 1)))
+	}
+	return vec3{}, false
+}
+
+// SphericalCompute treats X as longitude and Y as latitude, both in degrees,
+// and returns the convex hull of the points on the sphere, with edges
+// following great circles. The hull is returned as a lon/lat ring in
+// counter-clockwise order (as seen from above), without repeating the first
+// vertex. Longitudes are in the range (-180, 180], so rings crossing the
+// antimeridian and rings around the poles are handled.
+//
+// The points are projected onto a tangent plane with a gnomonic projection,
+// which maps great circles to straight lines, so the planar Graham Scan
+// gives the spherical hull. All points must therefore lie within an open
+// hemisphere, which is centered on their mean direction if possible. An
+// error is returned if the points do not fit in any open hemisphere, or
+// only with some of them within about 1e-9 radians of its edge.
+func (ps Points) SphericalCompute() (Points, error) {
+	if len(ps) < 3 {
+		return nil, errors.New("Too few points")
+	}
+
+	vs := make([]vec3, len(ps))
+	for i, p := range ps {
+		vs[i] = lonLatToVec3(p.X, p.Y)
+	}
+	c, ok := hemisphereCenter(vs)
+	if !ok {
+		return nil, errors.New("Points do not fit in a hemisphere")
+	}
+
+	// Pick a right-handed basis (e1, e2, c) for the tangent plane
+	axis := vec3{0, 0, 1}
+	if math.Abs(c.z) > 0.9 {
+		axis = vec3{1, 0, 0}
+	}
+	e1 := axis.cross(c)
+	e1 = e1.scale(1 / e1.norm())
+	e2 := c.cross(e1)
+
+	projected := make(Points, len(vs))
+	for i, v := range vs {
+		d := v.dot(c)
+		projected[i] = New(v.dot(e1)/d, v.dot(e2)/d)
+	}
+
+	hull, err := projected.Compute()
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute returns the hull in clockwise order, reverse it while mapping back
+	ret := make(Points, len(hull))
+	for i, p := range hull {
+		lon, lat := vec3ToLonLat(c.add(e1.scale(p.X)).add(e2.scale(p.Y)))
+		ret[len(hull)-1-i] = New(lon, lat)
+	}
+	return ret, nil
+}
@@ -0,0 +1,89 @@
+package convexhull
+
+import (
+	"fmt"
+	"math"
+)
+
+func ExamplePoints_SphericalCompute() {
+	// A region crossing the antimeridian, with one interior point
+	ps := Points{
+		&Point{170, -10},
+		&Point{-170, -10},
+		&Point{-170, 10},
+		&Point{170, 10},
+		&Point{180, 0},
+	}
+	hull, err := ps.SphericalCompute()
+	if err != nil {
+		panic(err)
+	}
+	for _, p := range hull {
+		fmt.Println(math.Round(p.X), math.Round(p.Y))
+	}
+	// Output:
+	// -170 -10
+	// -170 10
+	// 170 10
+	// 170 -10
+}
+
+func ExamplePoints_SphericalCompute_hemisphere() {
+	// The mean direction is close to the points around (0, 0), and (100, 0)
+	// is more than 90 degrees away from it, but all the points still fit in
+	// a hemisphere
+	ps := Points{
+		&Point{100, 0},
+		&Point{-1, -1},
+		&Point{1, -1},
+		&Point{1, 1},
+		&Point{-1, 1},
+	}
+	for i := 0; i < 6; i++ {
+		ps = append(ps, &Point{0.1 * float64(i), 0.1})
+	}
+	hull, err := ps.SphericalCompute()
+	if err != nil {
+		panic(err)
+	}
+	for _, p := range hull {
+		fmt.Println(math.Round(p.X), math.Round(p.Y))
+	}
+
+	// Points all around the equator do not fit in any open hemisphere
+	_, err = Points{
+		&Point{0, 0},
+		&Point{120, 0},
+		&Point{-120, 0},
+	}.SphericalCompute()
+	fmt.Println(err)
+	// Output:
+	// -1 -1
+	// 100 0
+	// -1 1
+	// Points do not fit in a hemisphere
+}
+
+func ExamplePoints_SphericalCompute_pole() {
+	// A region around the north pole, with the pole and another point
+	// inside. The corner on the antimeridian is given with the longitude
+	// 180.
+	ps := Points{
+		&Point{-180, 80},
+		&Point{-60, 80},
+		&Point{60, 80},
+		&Point{0, 90},
+		&Point{100, 85},
+	}
+	hull, err := ps.SphericalCompute()
+	if err != nil {
+		panic(err)
+	}
+	for _, p := range hull {
+		fmt.Println(math.Round(p.X), math.Round(p.Y))
+	}
+	// Output:
+	// 180 80
+	// -60 80
+	// 60 80
+}
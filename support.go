@@ -0,0 +1,61 @@
+package convexhull
+
+// Support returns the vertex of the polygon that is farthest in the
+// direction dir, the one with the largest dot product with dir. The edges
+// of a convex polygon turn the same way all around, so the vertex where
+// they start to turn away from dir is found by binary search over the edge
+// angles, in O(log n). If several vertices are equally far, any of them may
+// be returned.
+func (cp ConvexPolygon) Support(dir Point) Point {
+	if len(cp.vertices) == 0 {
+		return Point{}
+	}
+	return cp.vertices[cp.supportIndex(dir)]
+}
+
+// supportIndex returns the index of the vertex returned by Support
+func (cp ConvexPolygon) supportIndex(dir Point) int {
+	v := cp.vertices
+	n := len(v)
+	if n < 3 || dir == (Point{}) {
+		best := 0
+		for i, p := range v {
+			if dot(p, dir) > dot(v[best], dir) {
+				best = i
+			}
+		}
+		return best
+	}
+
+	// Angles are measured from the first edge. The farthest vertex is
+	// where the edges pass the direction at a right angle to dir.
+	e0 := Point{v[1].X - v[0].X, v[1].Y - v[0].Y}
+	relative := func(p Point) Point {
+		return Point{dot(e0, p), e0.X*p.Y - e0.Y*p.X}
+	}
+	target := relative(Point{-dir.Y, dir.X})
+	if !lessAngle(Point{1, 0}, target) {
+		return 0
+	}
+	lo, hi := 0, n
+	for hi-lo > 1 {
+		mid := (lo + hi) / 2
+		e := Point{v[(mid+1)%n].X - v[mid].X, v[(mid+1)%n].Y - v[mid].Y}
+		if lessAngle(relative(e), target) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi % n
+}
+
+// SupportAll returns the farthest vertex for each of many directions,
+// spreading the work over all available CPUs
+func (cp ConvexPolygon) SupportAll(dirs []Point) []Point {
+	ret := make([]Point, len(dirs))
+	parallel(len(dirs), func(i int) {
+		ret[i] = cp.Support(dirs[i])
+	})
+	return ret
+}
@@ -0,0 +1,49 @@
+package convexhull
+
+import (
+	"fmt"
+	"math"
+)
+
+func ExampleConvexPolygon_Support() {
+	hexagon, err := NewConvexPolygon(Points{&Point{2, 0}, &Point{4, 0}, &Point{6, 2}, &Point{4, 4}, &Point{2, 4}, &Point{0, 2}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(hexagon.Support(Point{1, 0}))
+	fmt.Println(hexagon.Support(Point{-1, 0}))
+	fmt.Println(hexagon.Support(Point{1, 2}))
+	fmt.Println(hexagon.Support(Point{-1, -3}))
+	fmt.Println(ConvexPolygon{}.Support(Point{1, 0}))
+	// Output:
+	// {6 2}
+	// {0 2}
+	// {4 4}
+	// {2 0}
+	// {0 0}
+}
+
+func ExampleConvexPolygon_SupportAll() {
+	hexagon, err := NewConvexPolygon(Points{&Point{2, 0}, &Point{4, 0}, &Point{6, 2}, &Point{4, 4}, &Point{2, 4}, &Point{0, 2}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(hexagon.SupportAll([]Point{{1, 0}, {1, 2}, {-1, 0}, {-1, -3}}))
+
+	// Every returned vertex is as far in its direction as any vertex
+	dirs := make([]Point, 1000)
+	for i := range dirs {
+		a := 2 * math.Pi * float64(i) / float64(len(dirs))
+		dirs[i] = Point{math.Cos(a), math.Sin(a)}
+	}
+	farthest := true
+	for i, s := range hexagon.SupportAll(dirs) {
+		for _, p := range hexagon.Vertices() {
+			farthest = farthest && dot(*p, dirs[i]) <= dot(s, dirs[i])
+		}
+	}
+	fmt.Println(farthest)
+	// Output:
+	// [{6 2} {4 4} {0 2} {2 0}]
+	// true
+}
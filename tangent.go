@@ -0,0 +1,96 @@
+package convexhull
+
+// Tangents returns the indices of the two vertices where the lines from an
+// outside point p touch the polygon. Looking from p, the whole polygon is to
+// the left of the line through the right tangent vertex, and to the right of
+// the line through the left tangent vertex. The indices refer to the order
+// returned by Vertices. If p is inside or on the boundary of the polygon, -1
+// is returned for both.
+//
+// The edges that can be seen from p form one connected chain, with the
+// tangent vertices at its ends. One visible and one hidden edge are found
+// with the fan of triangles from the first vertex, and the ends of the chain
+// are then found by binary search, in O(log n).
+func (cp ConvexPolygon) Tangents(p Point) (left, right int) {
+	v := cp.vertices
+	n := len(v)
+	if n == 0 || cp.Contains(p) {
+		return -1, -1
+	}
+	switch n {
+	case 1:
+		return 0, 0
+	case 2:
+		if Area2(p, v[0], v[1]) > 0 {
+			return 0, 1
+		}
+		return 1, 0
+	}
+
+	// visible returns true if p is to the right of edge i
+	visible := func(i int) bool {
+		i %= n
+		return Area2(v[i], v[(i+1)%n], p) < 0
+	}
+
+	var seen, hidden int
+	switch a, b := visible(0), visible(n-1); {
+	case a && b:
+		// Both edges at the first vertex are visible. The line from p
+		// through that vertex leaves the polygon through a hidden edge.
+		hidden = cp.fanWedge(Point{2*v[0].X - p.X, 2*v[0].Y - p.Y})
+	case a:
+		seen, hidden = 0, n-1
+	case b:
+		seen, hidden = n-1, 0
+	default:
+		// p is in the wedge at the first vertex, beyond a visible edge
+		seen = cp.fanWedge(p)
+	}
+
+	// Going counter-clockwise from hidden to seen, the visible edges are
+	// the last ones, and from seen to hidden they are the first ones
+	if seen < hidden {
+		seen += n
+	}
+	lo, hi := hidden, seen
+	for hi-lo > 1 {
+		mid := (lo + hi) / 2
+		if visible(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	left = hi % n
+
+	lo, hi = seen, hidden+n
+	for hi-lo > 1 {
+		mid := (lo + hi) / 2
+		if visible(mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	right = hi % n
+
+	return left, right
+}
+
+// fanWedge returns the index i of the edge from v[i] to v[i+1] that closes
+// the triangle of the fan from the first vertex that contains p. The point
+// must be in the wedge at the first vertex.
+func (cp ConvexPolygon) fanWedge(p Point) int {
+	v := cp.vertices
+	lo, hi := 1, len(v)-1
+	for hi-lo > 1 {
+		mid := (lo + hi) / 2
+		if Area2(v[0], v[mid], p) >= 0 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
@@ -0,0 +1,19 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleConvexPolygon_Tangents() {
+	square, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{2, 0}, &Point{2, 2}, &Point{0, 2}})
+	if err != nil {
+		panic(err)
+	}
+	left, right := square.Tangents(Point{1, -2})
+	vs := square.Vertices()
+	fmt.Println(*vs[left], *vs[right])
+	fmt.Println(square.Tangents(Point{1, 1}))
+	// Output:
+	// {0 0} {2 0}
+	// -1 -1
+}
@@ -0,0 +1,237 @@
+package convexhull
+
+import (
+	"errors"
+	"math"
+)
+
+// MinEnclosingTriangle returns the triangle with the smallest area that
+// encloses the polygon.
+//
+// As shown by Klee and Laskowski, and used by O'Rourke et al., a smallest
+// enclosing triangle has one side flush with an edge of the polygon, and
+// the midpoints of all three sides touch the polygon. For a side flush with
+// a given edge, this means that the smallest triangle has the area
+// max(2*h*w(h)), where w(h) is the width of the polygon at the height h
+// above the edge. The function is unimodal, and where it has its maximum,
+// the other two sides meet at the height 2h.
+//
+// The points at the height of the maximum, on the right and the left side
+// of the polygon, only move forward as the flush edge goes around the
+// polygon. They are tracked with the edges a and b that contain them, which
+// are only ever moved forward, so the whole search takes O(n) time.
+func (cp ConvexPolygon) MinEnclosingTriangle() ConvexPolygon {
+	v := cp.vertices
+	n := len(v)
+	if n < 3 {
+		return cp
+	}
+
+	var best []Point
+	bestArea := math.Inf(1)
+
+	// The indices keep counting past n while going around the polygon. The
+	// edge a goes up the right side from a-1 to a, and the edge b goes up
+	// the left side from b to b-1.
+	top, a, b := 1, 2, 2
+	for c := 0; c < n; c++ {
+		p, q := v[c], v[(c+1)%n]
+		l := math.Hypot(q.X-p.X, q.Y-p.Y)
+		ux, uy := (q.X-p.X)/l, (q.Y-p.Y)/l
+		x := func(k int) float64 {
+			k %= n
+			return (v[k].X-p.X)*ux + (v[k].Y-p.Y)*uy
+		}
+		y := func(k int) float64 {
+			k %= n
+			return (v[k].Y-p.Y)*ux - (v[k].X-p.X)*uy
+		}
+		// slope returns dx/dy along the edge from vertex i to vertex j
+		slope := func(i, j int) float64 {
+			return (x(j) - x(i)) / (y(j) - y(i))
+		}
+
+		// The right side goes up from c+1 to top, and the left side goes
+		// up from c+n, which is c, back to topLeft. They only differ if the
+		// top edge is parallel to the flush edge.
+		if top < c+1 {
+			top = c + 1
+		}
+		for y(top+1) > y(top) {
+			top++
+		}
+		topLeft := top
+		if y(top+1) == y(top) {
+			topLeft++
+		}
+		bottom := c + n
+		if a < c+2 {
+			a = c + 2
+		}
+		if b < topLeft+1 {
+			b = topLeft + 1
+		}
+
+		// Move a up and b down until the maximum is between the heights
+		// where both edges are, where the width is linear
+		// xa and xb return x at the height t on the lines through the
+		// edges a and b
+		xa := func(t float64) float64 {
+			return x(a-1) + (t-y(a-1))*slope(a-1, a)
+		}
+		xb := func(t float64) float64 {
+			return x(b) + (t-y(b))*slope(b, b-1)
+		}
+		var h float64
+		for {
+			if y(a) < y(b) {
+				// The edges are not at the same height. The top of the
+				// triangle is at least as high as b, and if the line
+				// through a is left of the line through b at twice the
+				// height of b, the maximum is below b.
+				if b < bottom && 2*y(a) >= y(b) && xa(2*y(b)) <= xb(2*y(b)) {
+					b++
+					continue
+				}
+				if a < top {
+					a++
+					continue
+				}
+			}
+
+			// The derivative of h*w(h) is the distance between the lines
+			// at the height 2h
+			lo, hi := math.Max(y(a-1), y(b)), math.Min(y(a), y(b-1))
+			dlo, dhi := xa(2*lo)-xb(2*lo), xa(2*hi)-xb(2*hi)
+			if dhi > 0 && hi == y(a) && a < top {
+				a++
+				continue
+			}
+			if dlo < 0 && lo == y(b) && b < bottom {
+				b++
+				continue
+			}
+			switch {
+			case dhi >= 0:
+				h = hi
+			case dlo <= 0:
+				h = lo
+			default:
+				h = lo + (hi-lo)*dlo/(dlo-dhi)
+			}
+			break
+		}
+
+		xl, xr := xb(h), xa(h)
+		area := 2 * h * (xr - xl)
+		if area >= bestArea {
+			continue
+		}
+
+		// The apex is at height 2h, on lines that support the polygon at
+		// the left and right points at height h. At a vertex, the lines
+		// can turn between the slopes of the two edges.
+		rslo, rshi := slope(a-1, a), slope(a-1, a)
+		switch {
+		case h == y(a) && a == top:
+			rshi = math.Inf(-1)
+		case h == y(a):
+			rshi = slope(a, a+1)
+		case h == y(a-1) && a-1 > c+1:
+			rshi = slope(a-2, a-1)
+		}
+		lslo, lshi := slope(b, b-1), slope(b, b-1)
+		switch {
+		case h == y(b-1) && b-1 == topLeft:
+			lshi = math.Inf(1)
+		case h == y(b-1):
+			lshi = slope(b-1, b-2)
+		case h == y(b) && b < bottom:
+			lshi = slope(b+1, b)
+		}
+		if rslo > rshi {
+			rslo, rshi = rshi, rslo
+		}
+		if lslo > lshi {
+			lslo, lshi = lshi, lslo
+		}
+		apexLo := math.Max(xl+h*lslo, xr+h*rslo)
+		apexHi := math.Min(xl+h*lshi, xr+h*rshi)
+		apexX := (apexLo + apexHi) / 2
+		if math.IsInf(apexLo, 0) {
+			apexX = apexHi
+		} else if math.IsInf(apexHi, 0) {
+			apexX = apexLo
+		}
+
+		point := func(px, py float64) Point {
+			return Point{p.X + px*ux - py*uy, p.Y + px*uy + py*ux}
+		}
+		bestArea = area
+		best = []Point{
+			point(2*xl-apexX, 0),
+			point(2*xr-apexX, 0),
+			point(apexX, 2*h),
+		}
+	}
+
+	return ConvexPolygon{best}
+}
+
+// MinEnclosingPolygon returns a convex polygon with at most k vertices
+// that encloses the polygon, with a small area. For k = 3 the smallest
+// triangle is returned. For larger k, the polygon is found with a greedy
+// heuristic: the edge that adds the least area when it is removed, by
+// extending its two neighbouring edges until they meet, is removed until
+// only k edges are left. This runs in O(n²).
+func (cp ConvexPolygon) MinEnclosingPolygon(k int) (ConvexPolygon, error) {
+	if k < 3 {
+		return ConvexPolygon{}, errors.New("Too few vertices")
+	}
+	if len(cp.vertices) <= k {
+		return cp, nil
+	}
+	if k == 3 {
+		return cp.MinEnclosingTriangle(), nil
+	}
+
+	vs := make([]Point, len(cp.vertices))
+	copy(vs, cp.vertices)
+	for len(vs) > k {
+		n := len(vs)
+		best, bestArea := -1, math.Inf(1)
+		var bestPoint Point
+		for i := range vs {
+			// Remove the edge from vs[i] to vs[i+1]
+			p, q := vs[i], vs[(i+1)%n]
+			prev, next := vs[(i+n-1)%n], vs[(i+2)%n]
+			d1x, d1y := p.X-prev.X, p.Y-prev.Y
+			d2x, d2y := q.X-next.X, q.Y-next.Y
+			denom := d1x*d2y - d1y*d2x
+			if denom >= 0 {
+				// The neighbouring edges do not meet beyond this edge
+				continue
+			}
+			s := ((q.X-p.X)*d2y - (q.Y-p.Y)*d2x) / denom
+			x := Point{p.X + s*d1x, p.Y + s*d1y}
+			if area := Area2(p, x, q); area < bestArea {
+				best, bestArea, bestPoint = i, area, x
+			}
+		}
+		if best < 0 {
+			return ConvexPolygon{}, errors.New("No edge can be removed")
+		}
+		out := make([]Point, 0, n-1)
+		for i := range vs {
+			switch {
+			case i == best:
+				out = append(out, bestPoint)
+			case i == (best+1)%n:
+			default:
+				out = append(out, vs[i])
+			}
+		}
+		vs = out
+	}
+	return ConvexPolygon{vs}, nil
+}
@@ -0,0 +1,59 @@
+package convexhull
+
+import (
+	"fmt"
+)
+
+func ExampleConvexPolygon_MinEnclosingTriangle() {
+	rect, err := NewConvexPolygon(Points{&Point{0, 0}, &Point{4, 0}, &Point{4, 1}, &Point{0, 1}})
+	if err != nil {
+		panic(err)
+	}
+	tri := rect.MinEnclosingTriangle()
+	encloses := true
+	for _, p := range rect.Vertices() {
+		encloses = encloses && tri.SignedDistance(*p) <= 1e-9
+	}
+	fmt.Printf("%d %.3f %v\n", tri.Len(), tri.Area(), encloses)
+
+	hexagon, err := NewConvexPolygon(Points{&Point{2, 0}, &Point{4, 0}, &Point{6, 2}, &Point{4, 4}, &Point{2, 4}, &Point{0, 2}})
+	if err != nil {
+		panic(err)
+	}
+	tri = hexagon.MinEnclosingTriangle()
+	encloses = true
+	for _, p := range hexagon.Vertices() {
+		encloses = encloses && tri.SignedDistance(*p) <= 1e-9
+	}
+	fmt.Printf("%d %.3f %v\n", tri.Len(), tri.Area(), encloses)
+	// Output:
+	// 3 8.000 true
+	// 3 25.000 true
+}
+
+func ExampleConvexPolygon_MinEnclosingPolygon() {
+	hexagon, err := NewConvexPolygon(Points{&Point{2, 0}, &Point{4, 0}, &Point{6, 2}, &Point{4, 4}, &Point{2, 4}, &Point{0, 2}})
+	if err != nil {
+		panic(err)
+	}
+	for k := 3; k <= 6; k++ {
+		cp, err := hexagon.MinEnclosingPolygon(k)
+		if err != nil {
+			panic(err)
+		}
+		encloses := true
+		for _, p := range hexagon.Vertices() {
+			encloses = encloses && cp.SignedDistance(*p) <= 1e-9
+		}
+		fmt.Printf("%d %.3f %v\n", cp.Len(), cp.Area(), encloses)
+	}
+	if _, err := hexagon.MinEnclosingPolygon(2); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 3 25.000 true
+	// 4 18.000 true
+	// 5 17.000 true
+	// 6 16.000 true
+	// Too few vertices
+}
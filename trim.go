@@ -0,0 +1,171 @@
+package convexhull
+
+import (
+	"sort"
+)
+
+// trimExact is the largest number of points that TrimmedHull removes by
+// trying all possibilities
+const trimExact = 3
+
+// trimSets is the largest number of sets of points that TrimmedHull tries
+// when searching for the exact answer
+const trimSets = 10000
+
+// sets returns the number of ways to choose up to k of n things, or
+// trimSets+1 if there are more than trimSets
+func sets(n, k int) int {
+	total, c := 1, 1
+	for j := 1; j <= k && j <= n; j++ {
+		c = c * (n - j + 1) / j
+		total += c
+		if total > trimSets {
+			return trimSets + 1
+		}
+	}
+	return total
+}
+
+// TrimmedHull returns the convex hull with the smallest area that is left
+// after removing k of the points, together with the removed points. Fewer
+// points are returned if removing more would not make the hull smaller.
+// The returned points are the given ones, not copies.
+//
+// Removing a point only changes the hull if it is a vertex, so the points
+// are removed one hull vertex at a time. Only the m points on the first k
+// convex layers can be removed this way, and of the points further in,
+// only the hull of the next layer matters. Finding the layers takes
+// O(k·n log n) time, or O(n²) when all the points are on the hull.
+//
+// For k up to 3, if there are at most 10000 ways to choose up to k of the
+// m points, every order of removing vertices is tried, which gives the
+// exact answer. Each set of removed points costs a new hull, for
+// O(m^k·m log m) time. Otherwise, the vertex that makes the hull smallest
+// is removed each time, which is a greedy heuristic that may miss the best
+// answer. Each of the k steps tries every vertex of the hull, in O(h·m)
+// time for a hull with h vertices.
+func TrimmedHull(ps Points, k int) (ConvexPolygon, Points) {
+	if k <= 0 || len(ps) == 0 {
+		return hullOf(ps), nil
+	}
+
+	// The points further in than the first k layers can not be removed,
+	// and only their hull matters, which is the next layer
+	layers, _ := peel(ps, k+1)
+	var candidates, inner Points
+	for i, layer := range layers {
+		if i < k {
+			candidates = append(candidates, layer...)
+		} else {
+			inner = hullOf(layer).Vertices()
+		}
+	}
+	exact := k <= trimExact && sets(len(candidates), k) <= trimSets
+
+	// Equal points must be removed together to move the hull
+	copies := make(map[Point][]int)
+	for i, p := range candidates {
+		copies[*p] = append(copies[*p], i)
+	}
+
+	removed := make([]bool, len(candidates))
+	left := func() Points {
+		ret := make(Points, 0, len(candidates)+len(inner))
+		for i, p := range candidates {
+			if !removed[i] {
+				ret = append(ret, p)
+			}
+		}
+		return append(ret, inner...)
+	}
+
+	best := hullOf(left())
+	bestArea := best.Area()
+	var bestRemoved []int
+
+	// When trying every order, the same points are often removed in
+	// different orders. The sorted indices of the removed points, shifted
+	// up by one so that 0 is unused, identify the state. The greedy search
+	// only follows one path, where the states are all different.
+	seen := make(map[[trimExact]int]bool)
+
+	var search func(used int)
+	search = func(used int) {
+		var current []int
+		for i := range removed {
+			if removed[i] {
+				current = append(current, i)
+			}
+		}
+		if exact {
+			var key [trimExact]int
+			for i, j := range current {
+				key[i] = j + 1
+			}
+			if seen[key] {
+				return
+			}
+			seen[key] = true
+		}
+
+		points := left()
+		hull := hullOf(points)
+		area := hull.Area()
+		if area < bestArea {
+			best, bestArea, bestRemoved = hull, area, current
+		}
+
+		// Find the vertices that can still be removed, and the area after
+		// removing each of them
+		type option struct {
+			indices []int
+			area    float64
+		}
+		var options []option
+		v := hull.vertices
+		n := len(v)
+		for i := range v {
+			indices := copies[v[i]]
+			if len(indices) == 0 || used+len(indices) > k {
+				continue
+			}
+			o := option{indices: indices}
+			if !exact && n >= 3 {
+				// Only the points in the triangle that is cut off can show
+				// up on the new boundary, between the two neighbours
+				prev, next := v[(i+n-1)%n], v[(i+1)%n]
+				pocket := Points{&prev, &next}
+				for _, p := range points {
+					if *p != v[i] && Area2(prev, v[i], *p) >= 0 && Area2(v[i], next, *p) >= 0 && Area2(next, prev, *p) >= 0 {
+						pocket = append(pocket, p)
+					}
+				}
+				o.area = area - Area2(prev, v[i], next)/2 + hullOf(pocket).Area()
+			}
+			options = append(options, o)
+		}
+		if !exact && len(options) > 1 {
+			sort.SliceStable(options, func(i, j int) bool {
+				return options[i].area < options[j].area
+			})
+			options = options[:1]
+		}
+
+		for _, o := range options {
+			for _, i := range o.indices {
+				removed[i] = true
+			}
+			search(used + len(o.indices))
+			for _, i := range o.indices {
+				removed[i] = false
+			}
+		}
+	}
+	search(0)
+
+	ret := make(Points, len(bestRemoved))
+	for i, j := range bestRemoved {
+		ret[i] = candidates[j]
+	}
+	return best, ret
+}
@@ -0,0 +1,40 @@
+package convexhull
+
+import (
+	"fmt"
+	"math"
+)
+
+func ExampleTrimmedHull() {
+	ps := Points{
+		&Point{0, 0},
+		&Point{2, 0},
+		&Point{2, 2},
+		&Point{0, 2},
+		&Point{1, 1},
+		&Point{50, 60}, // A bad GPS fix
+	}
+	hull, removed := TrimmedHull(ps, 1)
+	fmt.Println(hull.Area())
+	fmt.Println(removed)
+	// Output:
+	// 4
+	// [{50 60}]
+}
+
+func ExampleTrimmedHull_track() {
+	// A round trip of 500 GPS fixes, where all the fixes are on the hull,
+	// with three bad fixes
+	var ps Points
+	for i := 0; i < 500; i++ {
+		a := 2 * math.Pi * float64(i) / 500
+		ps = append(ps, &Point{100 * math.Cos(a), 100 * math.Sin(a)})
+	}
+	ps = append(ps, &Point{300, 0}, &Point{0, -250}, &Point{-200, 200})
+	hull, removed := TrimmedHull(ps, 3)
+	fmt.Printf("%d %.0f\n", hull.Len(), hull.Area())
+	fmt.Println(removed)
+	// Output:
+	// 500 31415
+	// [{-200 200} {0 -250} {300 0}]
+}